@@ -2,74 +2,267 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"fmt"
 	"full-stack-assesment/internal/api"
+	"full-stack-assesment/internal/config"
+	"full-stack-assesment/internal/docs"
+	"full-stack-assesment/internal/metrics"
 	"full-stack-assesment/internal/middleware"
 	"full-stack-assesment/internal/migrate"
+	idempotencyRepo "full-stack-assesment/internal/repo/idempotency"
+	labelsRepo "full-stack-assesment/internal/repo/labels"
 	projectsRepo "full-stack-assesment/internal/repo/projects"
 	tasksRepo "full-stack-assesment/internal/repo/task"
+	viewsRepo "full-stack-assesment/internal/repo/views"
+	"full-stack-assesment/internal/requestid"
+	batchService "full-stack-assesment/internal/service/batch"
+	healthService "full-stack-assesment/internal/service/health"
+	labelsService "full-stack-assesment/internal/service/labels"
 	projectsService "full-stack-assesment/internal/service/projects"
 	taskService "full-stack-assesment/internal/service/task"
+	viewsService "full-stack-assesment/internal/service/views"
+	"full-stack-assesment/internal/worker"
 
 	"full-stack-assesment/internal/store"
-	"log"
+	"full-stack-assesment/internal/telemetry"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
+// Exit codes reported to the orchestrator.
 const (
-	address = "0.0.0.0:8080"
+	exitOK     = 0
+	exitFailed = 1
+	exitConfig = 2
 )
 
-func init() {
-	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
-}
-
 func main() {
-	ctx := context.Background()
+	os.Exit(realMain())
+}
 
-	if err := run(ctx); err != nil {
-		panic(err)
+func realMain() int {
+	cfg, err := config.Load(os.Args[1:], os.Getenv)
+	if errors.Is(err, flag.ErrHelp) {
+		return exitOK
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "config: %v\n", err)
+		return exitConfig
 	}
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, cfg); err != nil {
+		slog.Error("server exited with error", slog.Any("error", err))
+		return exitFailed
+	}
+	return exitOK
 }
 
-func run(ctx context.Context) error {
-	db, err := store.InMemory(ctx)
+// run starts serving, applies migrations and opens the readiness gate, then
+// serves until ctx is cancelled and shuts down in dependency order:
+// stop accepting and drain HTTP requests, stop background workers,
+// checkpoint and close the database, then flush the span exporter.
+func run(ctx context.Context, cfg config.Config) (err error) {
+	level, _ := cfg.Log.SlogLevel()
+	slog.SetDefault(slog.New(requestid.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: level}))))
+
+	exporter, err := telemetry.NewExporter(cfg.Tracing.Exporter, cfg.Tracing.File, "full-stack-backend")
 	if err != nil {
-		log.Fatalf("db init: %v", err)
+		return fmt.Errorf("tracing: %w", err)
 	}
+	tracer := telemetry.NewTracer(exporter, telemetry.ParentBasedRatio(cfg.Tracing.SampleRatio))
+	telemetry.SetDefault(tracer)
+	defer func() {
+		if terr := tracer.Shutdown(context.Background()); terr != nil {
+			err = errors.Join(err, fmt.Errorf("tracing shutdown: %w", terr))
+		}
+	}()
 
-	if err := migrate.Apply(ctx, db); err != nil {
-		log.Fatalf("migrations: %v", err)
+	db, err := store.Open(ctx, store.Config{
+		DSN:          cfg.DB.DSN,
+		BusyTimeout:  cfg.DB.BusyTimeout,
+		MaxReadConns: cfg.DB.MaxReadConns,
+	})
+	if err != nil {
+		return fmt.Errorf("db init: %w", err)
 	}
+	defer func() {
+		if cerr := db.Close(); cerr != nil {
+			err = errors.Join(err, fmt.Errorf("db close: %w", cerr))
+		}
+	}()
 
 	projectsRepo := projectsRepo.NewSQLiteProjectsRepo(db)
 	taskRepo := tasksRepo.NewSQLiteTaskRepo(db)
+	viewsRepo := viewsRepo.NewSQLiteViewsRepo(db)
+	labelsRepo := labelsRepo.NewSQLiteLabelsRepo(db)
+	idempotencyKeys := idempotencyRepo.NewSQLiteIdempotencyRepo(db)
 
-	projectsService := projectsService.NewService(*projectsRepo)
-	tasksService := taskService.NewService(*taskRepo, *projectsService)
+	projectsService := projectsService.NewService(*projectsRepo, cfg.Limits)
+	tasksService := taskService.NewService(*taskRepo, *projectsService, cfg.Limits, cfg.Tasks)
+	viewsService := viewsService.NewService(*viewsRepo, *projectsService, *tasksService, cfg.Limits)
+	labelsService := labelsService.NewService(*labelsRepo, *projectsService)
 
-	server := api.NewServer(*projectsService, *tasksService)
+	healthService := healthService.NewService(db)
+	batchService := batchService.NewService(db, cfg.Limits)
+
+	operations, err := api.OperationIDs("")
+	if err != nil {
+		return fmt.Errorf("load spec: %w", err)
+	}
+	registry := metrics.NewRegistry()
+	httpMetrics := metrics.NewHTTP(registry)
+	metrics.RegisterDBStats(registry, db.PoolStats)
+	registerDomainMetrics(registry, tasksService)
+
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("load spec: %w", err)
+	}
+	apiDocs := docs.New(spec)
+
+	server := api.NewServer(*projectsService, *tasksService, *viewsService, *labelsService, healthService, batchService)
 	router := http.NewServeMux()
-	h := api.HandlerFromMux(server, router)
+	router.Handle("GET /metrics", registry.Handler())
+	router.Handle("GET /openapi.json", apiDocs.JSON())
+	router.Handle("GET /openapi.yaml", apiDocs.YAML())
+	router.Handle("GET /docs", apiDocs.Explorer())
+	h := api.HandlerWithOptions(server, api.StdHTTPServerOptions{
+		BaseRouter:       router,
+		ErrorHandlerFunc: api.ParamErrorHandler,
+	})
+
+	validate, err := middleware.ValidationMiddleware(spec, middleware.ValidationOptions{})
+	if err != nil {
+		return err
+	}
 
-	handler := middleware.RecoverMiddleware(
-		middleware.LoggingMiddleware(
-			middleware.CORSMiddleware(h),
+	operation := func(r *http.Request) string { return api.Operation(operations, r) }
+	handler := middleware.RequestIDMiddleware(
+		middleware.RecoverMiddleware(httpMetrics.Panics)(
+			middleware.LoggingMiddleware(
+				middleware.CORSMiddleware(cfg.CORS.AllowedOrigins)(
+					middleware.MetricsMiddleware(httpMetrics, operation)(
+						middleware.TracingMiddleware(operation)(
+							validate(middleware.IdempotencyMiddleware(idempotencyKeys, cfg.Idempotency.TTL)(h)),
+						),
+					),
+				),
+			),
 		),
 	)
 
+	// Sub-requests of a batch take the same path as any other request.
+	batchService.Route(handler)
+
+	workers := worker.NewGroup(context.WithoutCancel(ctx))
+
 	s := &http.Server{
 		Handler: handler,
-		Addr:    address,
+		Addr:    cfg.Server.Address,
+		// Requests keep running during the drain even though ctx is done.
+		BaseContext: func(net.Listener) context.Context { return context.WithoutCancel(ctx) },
 	}
 
-	slog.LogAttrs(ctx, slog.LevelInfo, "Starting server", slog.String("address", address))
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.LogAttrs(ctx, slog.LevelInfo, "Starting server", slog.String("address", cfg.Server.Address))
+		if err := s.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- err
+		}
+		close(serveErr)
+	}()
 
-	if err := s.ListenAndServe(); err != nil {
+	// Probes are served while migrations run; readiness stays false until
+	// they have finished.
+	if err := migrate.Apply(ctx, db.DB); err != nil {
+		_ = s.Close()
+		_ = workers.Stop(context.Background())
+		<-serveErr
+		return fmt.Errorf("migrations: %w", err)
+	}
+	workers.Go("idempotency-cleanup", func(ctx context.Context) error {
+		return purgeIdempotencyKeys(ctx, idempotencyKeys, cfg.Idempotency.CleanupInterval)
+	})
+	healthService.MarkReady()
+
+	select {
+	case err := <-serveErr:
+		_ = workers.Stop(context.Background())
 		return err
+	case <-ctx.Done():
+	}
+
+	slog.LogAttrs(ctx, slog.LevelInfo, "Shutting down", slog.Duration("timeout", cfg.Server.ShutdownTimeout))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	if err := s.Shutdown(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("drain requests: %w", err))
+		_ = s.Close()
+	}
+	if err := workers.Stop(shutdownCtx); err != nil {
+		errs = append(errs, fmt.Errorf("stop workers: %w", err))
+	}
+	if err := <-serveErr; err != nil {
+		errs = append(errs, err)
 	}
 
-	return nil
+	slog.LogAttrs(ctx, slog.LevelInfo, "Server stopped")
+	return errors.Join(errs...)
+}
+
+// registerDomainMetrics exposes gauges computed from the database at scrape
+// time.
+func registerDomainMetrics(registry *metrics.Registry, tasks *taskService.TaskService) {
+	registry.Collect("todo_tasks", "Tasks per project and status.", metrics.KindGauge,
+		[]string{"project_id", "status"},
+		func(ctx context.Context) ([]metrics.Sample, error) {
+			counts, err := tasks.CountByStatus(ctx)
+			if err != nil {
+				return nil, err
+			}
+			samples := make([]metrics.Sample, 0, len(counts))
+			for _, c := range counts {
+				samples = append(samples, metrics.Sample{
+					LabelValues: []string{c.ProjectID, c.Status},
+					Value:       float64(c.Count),
+				})
+			}
+			return samples, nil
+		})
+}
+
+// purgeIdempotencyKeys deletes expired idempotency keys every interval until
+// ctx is cancelled. Failures are logged and retried on the next tick.
+func purgeIdempotencyKeys(ctx context.Context, keys *idempotencyRepo.SQLiteIdempotencyRepo, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		n, err := keys.DeleteExpired(ctx, time.Now().UTC())
+		if err != nil {
+			slog.WarnContext(ctx, "purge idempotency keys", slog.Any("error", err))
+			continue
+		}
+		if n > 0 {
+			slog.LogAttrs(ctx, slog.LevelDebug, "Purged expired idempotency keys", slog.Int64("count", n))
+		}
+	}
 }
@@ -10,13 +10,7 @@ export interface Task {
     status: TaskStatus;
     createdAt: string;
     updatedAt: string;
-}
-
-interface Project {
-    id: string;
-    name: string;
-    createdAt: string;
-    updatedAt: string;
+    projectName?: string;
 }
 
 const toError = async (response: Response) => {
@@ -34,28 +28,26 @@ const toError = async (response: Response) => {
     return new Error(`Request failed with status ${response.status}${details}`);
 };
 
-export async function fetchAllTasks(): Promise<Task[]> {
-    const projectResponse = await fetch(`${API_BASE_URL}/projects`);
-    if (!projectResponse.ok) {
-        throw await toError(projectResponse);
-    }
+const nextLink = (response: Response) => {
+    const header = response.headers.get('Link') ?? '';
+    const match = /<([^>]*)>;\s*rel="next"/.exec(header);
+    return match ? match[1] : null;
+};
 
-    const projects: Project[] = await projectResponse.json();
-    if (!Array.isArray(projects) || projects.length === 0) {
-        return [];
-    }
+export async function fetchAllTasks(): Promise<Task[]> {
+    const tasks: Task[] = [];
+    let next: string | null = '/tasks?embed=project&limit=200';
 
-    const taskLists = await Promise.all(
-        projects.map(async (project) => {
-            const taskResponse = await fetch(`${API_BASE_URL}/projects/${project.id}/tasks`);
-            if (!taskResponse.ok) {
-                throw await toError(taskResponse);
-            }
+    while (next) {
+        const response = await fetch(`${API_BASE_URL}${next}`);
+        if (!response.ok) {
+            throw await toError(response);
+        }
 
-            const tasks: Task[] = await taskResponse.json();
-            return tasks;
-        }),
-    );
+        const page: Task[] = await response.json();
+        tasks.push(...page);
+        next = nextLink(response);
+    }
 
-    return taskLists.flat();
-}
\ No newline at end of file
+    return tasks;
+}
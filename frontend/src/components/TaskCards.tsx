@@ -119,7 +119,7 @@ export function TaskCards() {
                                 </Badge>
                             </Group>
                             <div className={classes.meta}>
-                                <span>Project ID: {task.projectId}</span>
+                                <span>Project: {task.projectName ?? task.projectId}</span>
                                 <span>Updated: {formatDate(task.updatedAt)}</span>
                             </div>
                         </Card>
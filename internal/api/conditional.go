@@ -0,0 +1,27 @@
+package api
+
+import (
+	"net/http"
+
+	"full-stack-assesment/internal/etag"
+	"full-stack-assesment/internal/helpers"
+)
+
+// writeVersioned sends a single resource together with the ETag of version.
+func writeVersioned(w http.ResponseWriter, status, version int, v any) {
+	w.Header().Set("ETag", etag.For(version))
+	helpers.WriteJSON(w, status, v)
+}
+
+// notModified answers 304 Not Modified when If-None-Match already names
+// version, sparing the client a body it has cached, and reports whether it
+// did.
+func notModified(w http.ResponseWriter, ifNoneMatch *string, version int) bool {
+	tag := etag.For(version)
+	if ifNoneMatch == nil || !etag.MatchesWeak(*ifNoneMatch, tag) {
+		return false
+	}
+	w.Header().Set("ETag", tag)
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
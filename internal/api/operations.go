@@ -0,0 +1,44 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+)
+
+// OperationIDs maps the ServeMux patterns registered by HandlerWithOptions
+// ("GET /projects/{projectId}") to the operationId of the embedded spec, which
+// oapi-codegen normalises to the ServerInterface method name ("GetProject"),
+// so middleware can label requests by operation using r.Pattern.
+func OperationIDs(baseURL string) (map[string]string, error) {
+	spec, err := GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	ops := make(map[string]string)
+	for path, item := range spec.Paths.Map() {
+		for method, op := range item.Operations() {
+			if op.OperationID == "" {
+				continue
+			}
+			ops[strings.ToUpper(method)+" "+baseURL+path] = op.OperationID
+		}
+	}
+	return ops, nil
+}
+
+// Operation returns the operation of the route that served r, the path of the
+// pattern for routes outside the spec such as /metrics, or "unmatched" when no
+// route did. r.Pattern is only set once the ServeMux has routed r.
+func Operation(ops map[string]string, r *http.Request) string {
+	if r.Pattern == "" {
+		return "unmatched"
+	}
+	if op, ok := ops[r.Pattern]; ok {
+		return op
+	}
+	if _, path, ok := strings.Cut(r.Pattern, " "); ok {
+		return path
+	}
+	return r.Pattern
+}
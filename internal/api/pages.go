@@ -0,0 +1,42 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/pagination"
+	"full-stack-assesment/internal/scheme"
+)
+
+// writePage sends one page of a listing. The paging metadata always goes in
+// the X-Total-Count and Link headers; with envelope it is also wrapped around
+// the items, otherwise the body is the bare array existing clients expect.
+func writePage[T any](w http.ResponseWriter, r *http.Request, page pagination.Page[T], envelope *bool) {
+	w.Header().Set("X-Total-Count", strconv.Itoa(page.Total))
+	w.Header().Set("Link", page.Links(r.URL))
+
+	items := page.Items
+	if items == nil {
+		items = []T{}
+	}
+	if envelope == nil || !*envelope {
+		helpers.WriteJSON(w, http.StatusOK, items)
+		return
+	}
+
+	info := scheme.PageInfo{Limit: page.Limit, Total: page.Total}
+	if page.Offset > 0 {
+		info.Offset = &page.Offset
+	}
+	if page.Next != "" {
+		info.NextCursor = &page.Next
+	}
+	if page.Prev != "" {
+		info.PrevCursor = &page.Prev
+	}
+	helpers.WriteJSON(w, http.StatusOK, struct {
+		Items []T             `json:"items"`
+		Page  scheme.PageInfo `json:"page"`
+	}{items, info})
+}
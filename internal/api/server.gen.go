@@ -24,30 +24,111 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Run several API requests in one round trip.
+	// (POST /batch)
+	Batch(w http.ResponseWriter, r *http.Request, params BatchParams)
 	// Health Check
 	// (GET /health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Liveness probe
+	// (GET /health/live)
+	GetLiveness(w http.ResponseWriter, r *http.Request)
+	// Readiness probe
+	// (GET /health/ready)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
 	// List projects.
 	// (GET /projects)
-	ListProjects(w http.ResponseWriter, r *http.Request)
+	ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams)
 	// Create a new project.
 	// (POST /projects)
-	CreateProject(w http.ResponseWriter, r *http.Request)
+	CreateProject(w http.ResponseWriter, r *http.Request, params CreateProjectParams)
+	// Delete a project.
+	// (DELETE /projects/{projectId})
+	DeleteProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params DeleteProjectParams)
+	// Get a project by ID.
+	// (GET /projects/{projectId})
+	GetProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectParams)
+	// Update a project name.
+	// (PUT /projects/{projectId})
+	UpdateProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params UpdateProjectParams)
+	// List the labels of a project.
+	// (GET /projects/{projectId}/labels)
+	ListLabels(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Create a label.
+	// (POST /projects/{projectId}/labels)
+	CreateLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params CreateLabelParams)
+	// Delete a label.
+	// (DELETE /projects/{projectId}/labels/{labelId})
+	DeleteLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, labelId openapi_types.UUID)
+	// Get a label.
+	// (GET /projects/{projectId}/labels/{labelId})
+	GetLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, labelId openapi_types.UUID)
+	// Update a label (partial).
+	// (PUT /projects/{projectId}/labels/{labelId})
+	UpdateLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, labelId openapi_types.UUID)
 	// List tasks in a project.
 	// (GET /projects/{projectId}/tasks)
 	ListTasks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListTasksParams)
 	// Create a task in a project.
 	// (POST /projects/{projectId}/tasks)
-	CreateTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	CreateTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params CreateTaskParams)
 	// Delete a task.
 	// (DELETE /projects/{projectId}/tasks/{taskId})
-	DeleteTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID)
+	DeleteTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, params DeleteTaskParams)
 	// Get a task by ID.
 	// (GET /projects/{projectId}/tasks/{taskId})
-	GetTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID)
+	GetTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, params GetTaskParams)
+	// Patch a task.
+	// (PATCH /projects/{projectId}/tasks/{taskId})
+	PatchTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, params PatchTaskParams)
 	// Update a task (partial).
 	// (PUT /projects/{projectId}/tasks/{taskId})
-	UpdateTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID)
+	UpdateTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, params UpdateTaskParams)
+	// Stop a task blocking another.
+	// (DELETE /projects/{projectId}/tasks/{taskId}/blockers/{blockerId})
+	RemoveTaskBlocker(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, blockerId openapi_types.UUID)
+	// Make a task block another.
+	// (PUT /projects/{projectId}/tasks/{taskId}/blockers/{blockerId})
+	AddTaskBlocker(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, blockerId openapi_types.UUID)
+	// List the subtasks of a task.
+	// (GET /projects/{projectId}/tasks/{taskId}/children)
+	ListTaskChildren(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, params ListTaskChildrenParams)
+	// Take a label off a task.
+	// (DELETE /projects/{projectId}/tasks/{taskId}/labels/{labelId})
+	RemoveTaskLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, labelId openapi_types.UUID)
+	// Put a label on a task.
+	// (PUT /projects/{projectId}/tasks/{taskId}/labels/{labelId})
+	AddTaskLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, labelId openapi_types.UUID)
+	// Get a task with all its subtasks.
+	// (GET /projects/{projectId}/tasks/{taskId}/tree)
+	GetTaskTree(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID)
+	// Run task operations in bulk.
+	// (POST /projects/{projectId}/tasks:bulk)
+	BulkTasks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params BulkTasksParams)
+	// List the saved views of a project.
+	// (GET /projects/{projectId}/views)
+	ListViews(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Save a view.
+	// (POST /projects/{projectId}/views)
+	CreateView(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params CreateViewParams)
+	// Delete a saved view.
+	// (DELETE /projects/{projectId}/views/{viewId})
+	DeleteView(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, viewId openapi_types.UUID)
+	// Get a saved view.
+	// (GET /projects/{projectId}/views/{viewId})
+	GetView(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, viewId openapi_types.UUID)
+	// Update a saved view (partial).
+	// (PUT /projects/{projectId}/views/{viewId})
+	UpdateView(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, viewId openapi_types.UUID)
+	// List the tasks of a saved view.
+	// (GET /projects/{projectId}/views/{viewId}/tasks)
+	ListViewTasks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, viewId openapi_types.UUID, params ListViewTasksParams)
+	// List tasks across projects.
+	// (GET /tasks)
+	ListAllTasks(w http.ResponseWriter, r *http.Request, params ListAllTasksParams)
+	// Search tasks.
+	// (GET /tasks/search)
+	SearchTasks(w http.ResponseWriter, r *http.Request, params SearchTasksParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -59,6 +140,46 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// Batch operation middleware
+func (siw *ServerInterfaceWrapper) Batch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BatchParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Batch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetHealth operation middleware
 func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
 
@@ -73,11 +194,84 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// GetLiveness operation middleware
+func (siw *ServerInterfaceWrapper) GetLiveness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLiveness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReadiness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListProjects operation middleware
 func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "envelope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "envelope", r.URL.Query(), &params.Envelope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envelope", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProjects(w, r)
+		siw.Handler.ListProjects(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -90,8 +284,34 @@ func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.R
 // CreateProject operation middleware
 func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateProjectParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProject(w, r)
+		siw.Handler.CreateProject(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -101,8 +321,8 @@ func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListTasks operation middleware
-func (siw *ServerInterfaceWrapper) ListTasks(w http.ResponseWriter, r *http.Request) {
+// DeleteProject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -116,42 +336,203 @@ func (siw *ServerInterfaceWrapper) ListTasks(w http.ResponseWriter, r *http.Requ
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTasksParams
+	var params DeleteProjectParams
 
-	// ------------- Optional query parameter "status" -------------
+	headers := r.Header
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProject(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProject operation middleware
+func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "q" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectParams
 
-	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProject(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProject operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateProjectParams
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProject(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListLabels operation middleware
+func (siw *ServerInterfaceWrapper) ListLabels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLabels(w, r, projectId)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateLabel operation middleware
+func (siw *ServerInterfaceWrapper) CreateLabel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateLabelParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTasks(w, r, projectId, params)
+		siw.Handler.CreateLabel(w, r, projectId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -161,8 +542,8 @@ func (siw *ServerInterfaceWrapper) ListTasks(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTask operation middleware
-func (siw *ServerInterfaceWrapper) CreateTask(w http.ResponseWriter, r *http.Request) {
+// DeleteLabel operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLabel(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -175,8 +556,17 @@ func (siw *ServerInterfaceWrapper) CreateTask(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Path parameter "labelId" -------------
+	var labelId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "labelId", r.PathValue("labelId"), &labelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTask(w, r, projectId)
+		siw.Handler.DeleteLabel(w, r, projectId, labelId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -186,8 +576,8 @@ func (siw *ServerInterfaceWrapper) CreateTask(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTask operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTask(w http.ResponseWriter, r *http.Request) {
+// GetLabel operation middleware
+func (siw *ServerInterfaceWrapper) GetLabel(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -200,17 +590,17 @@ func (siw *ServerInterfaceWrapper) DeleteTask(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Path parameter "taskId" -------------
-	var taskId openapi_types.UUID
+	// ------------- Path parameter "labelId" -------------
+	var labelId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "labelId", r.PathValue("labelId"), &labelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTask(w, r, projectId, taskId)
+		siw.Handler.GetLabel(w, r, projectId, labelId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -220,8 +610,8 @@ func (siw *ServerInterfaceWrapper) DeleteTask(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetTask operation middleware
-func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Request) {
+// UpdateLabel operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLabel(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -234,17 +624,17 @@ func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// ------------- Path parameter "taskId" -------------
-	var taskId openapi_types.UUID
+	// ------------- Path parameter "labelId" -------------
+	var labelId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "labelId", r.PathValue("labelId"), &labelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTask(w, r, projectId, taskId)
+		siw.Handler.UpdateLabel(w, r, projectId, labelId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -254,8 +644,8 @@ func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateTask operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTask(w http.ResponseWriter, r *http.Request) {
+// ListTasks operation middleware
+func (siw *ServerInterfaceWrapper) ListTasks(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -268,17 +658,1274 @@ func (siw *ServerInterfaceWrapper) UpdateTask(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Path parameter "taskId" -------------
-	var taskId openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTasksParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "topLevel" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "topLevel", r.URL.Query(), &params.TopLevel)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "topLevel", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelMatch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelMatch", r.URL.Query(), &params.LabelMatch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelMatch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "updatedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updatedAfter", r.URL.Query(), &params.UpdatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updatedAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "updatedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updatedBefore", r.URL.Query(), &params.UpdatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updatedBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "envelope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "envelope", r.URL.Query(), &params.Envelope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envelope", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTasks(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTask operation middleware
+func (siw *ServerInterfaceWrapper) CreateTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateTaskParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTask(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTask operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTaskParams
+
+	// ------------- Optional query parameter "subtasks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subtasks", r.URL.Query(), &params.Subtasks)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subtasks", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTask(w, r, projectId, taskId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTask operation middleware
+func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTaskParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTask(w, r, projectId, taskId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchTask operation middleware
+func (siw *ServerInterfaceWrapper) PatchTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTaskParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchTask(w, r, projectId, taskId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTask operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTaskParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTask(w, r, projectId, taskId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveTaskBlocker operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTaskBlocker(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "blockerId" -------------
+	var blockerId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blockerId", r.PathValue("blockerId"), &blockerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "blockerId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveTaskBlocker(w, r, projectId, taskId, blockerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTaskBlocker operation middleware
+func (siw *ServerInterfaceWrapper) AddTaskBlocker(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "blockerId" -------------
+	var blockerId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blockerId", r.PathValue("blockerId"), &blockerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "blockerId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTaskBlocker(w, r, projectId, taskId, blockerId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTaskChildren operation middleware
+func (siw *ServerInterfaceWrapper) ListTaskChildren(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTaskChildrenParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "envelope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "envelope", r.URL.Query(), &params.Envelope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envelope", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTaskChildren(w, r, projectId, taskId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveTaskLabel operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTaskLabel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "labelId" -------------
+	var labelId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "labelId", r.PathValue("labelId"), &labelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveTaskLabel(w, r, projectId, taskId, labelId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddTaskLabel operation middleware
+func (siw *ServerInterfaceWrapper) AddTaskLabel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "labelId" -------------
+	var labelId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "labelId", r.PathValue("labelId"), &labelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTaskLabel(w, r, projectId, taskId, labelId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTaskTree operation middleware
+func (siw *ServerInterfaceWrapper) GetTaskTree(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "taskId", r.PathValue("taskId"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "taskId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTaskTree(w, r, projectId, taskId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BulkTasks operation middleware
+func (siw *ServerInterfaceWrapper) BulkTasks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BulkTasksParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkTasks(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListViews operation middleware
+func (siw *ServerInterfaceWrapper) ListViews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListViews(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateView operation middleware
+func (siw *ServerInterfaceWrapper) CreateView(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateViewParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateView(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteView operation middleware
+func (siw *ServerInterfaceWrapper) DeleteView(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "viewId" -------------
+	var viewId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "viewId", r.PathValue("viewId"), &viewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "viewId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteView(w, r, projectId, viewId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetView operation middleware
+func (siw *ServerInterfaceWrapper) GetView(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "viewId" -------------
+	var viewId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "viewId", r.PathValue("viewId"), &viewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "viewId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetView(w, r, projectId, viewId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateView operation middleware
+func (siw *ServerInterfaceWrapper) UpdateView(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "viewId" -------------
+	var viewId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "viewId", r.PathValue("viewId"), &viewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "viewId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateView(w, r, projectId, viewId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListViewTasks operation middleware
+func (siw *ServerInterfaceWrapper) ListViewTasks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "viewId" -------------
+	var viewId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "viewId", r.PathValue("viewId"), &viewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "viewId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListViewTasksParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "envelope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "envelope", r.URL.Query(), &params.Envelope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envelope", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListViewTasks(w, r, projectId, viewId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAllTasks operation middleware
+func (siw *ServerInterfaceWrapper) ListAllTasks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAllTasksParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "embed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "embed", r.URL.Query(), &params.Embed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "embed", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "topLevel" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "topLevel", r.URL.Query(), &params.TopLevel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "topLevel", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelMatch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelMatch", r.URL.Query(), &params.LabelMatch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelMatch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "createdBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "createdBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "updatedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updatedAfter", r.URL.Query(), &params.UpdatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updatedAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "updatedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updatedBefore", r.URL.Query(), &params.UpdatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updatedBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "envelope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "envelope", r.URL.Query(), &params.Envelope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "envelope", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAllTasks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchTasks operation middleware
+func (siw *ServerInterfaceWrapper) SearchTasks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchTasksParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTask(w, r, projectId, taskId)
+		siw.Handler.SearchTasks(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -408,14 +2055,41 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("POST "+options.BaseURL+"/batch", wrapper.Batch)
 	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.GetHealth)
+	m.HandleFunc("GET "+options.BaseURL+"/health/live", wrapper.GetLiveness)
+	m.HandleFunc("GET "+options.BaseURL+"/health/ready", wrapper.GetReadiness)
 	m.HandleFunc("GET "+options.BaseURL+"/projects", wrapper.ListProjects)
 	m.HandleFunc("POST "+options.BaseURL+"/projects", wrapper.CreateProject)
+	m.HandleFunc("DELETE "+options.BaseURL+"/projects/{projectId}", wrapper.DeleteProject)
+	m.HandleFunc("GET "+options.BaseURL+"/projects/{projectId}", wrapper.GetProject)
+	m.HandleFunc("PUT "+options.BaseURL+"/projects/{projectId}", wrapper.UpdateProject)
+	m.HandleFunc("GET "+options.BaseURL+"/projects/{projectId}/labels", wrapper.ListLabels)
+	m.HandleFunc("POST "+options.BaseURL+"/projects/{projectId}/labels", wrapper.CreateLabel)
+	m.HandleFunc("DELETE "+options.BaseURL+"/projects/{projectId}/labels/{labelId}", wrapper.DeleteLabel)
+	m.HandleFunc("GET "+options.BaseURL+"/projects/{projectId}/labels/{labelId}", wrapper.GetLabel)
+	m.HandleFunc("PUT "+options.BaseURL+"/projects/{projectId}/labels/{labelId}", wrapper.UpdateLabel)
 	m.HandleFunc("GET "+options.BaseURL+"/projects/{projectId}/tasks", wrapper.ListTasks)
 	m.HandleFunc("POST "+options.BaseURL+"/projects/{projectId}/tasks", wrapper.CreateTask)
 	m.HandleFunc("DELETE "+options.BaseURL+"/projects/{projectId}/tasks/{taskId}", wrapper.DeleteTask)
 	m.HandleFunc("GET "+options.BaseURL+"/projects/{projectId}/tasks/{taskId}", wrapper.GetTask)
+	m.HandleFunc("PATCH "+options.BaseURL+"/projects/{projectId}/tasks/{taskId}", wrapper.PatchTask)
 	m.HandleFunc("PUT "+options.BaseURL+"/projects/{projectId}/tasks/{taskId}", wrapper.UpdateTask)
+	m.HandleFunc("DELETE "+options.BaseURL+"/projects/{projectId}/tasks/{taskId}/blockers/{blockerId}", wrapper.RemoveTaskBlocker)
+	m.HandleFunc("PUT "+options.BaseURL+"/projects/{projectId}/tasks/{taskId}/blockers/{blockerId}", wrapper.AddTaskBlocker)
+	m.HandleFunc("GET "+options.BaseURL+"/projects/{projectId}/tasks/{taskId}/children", wrapper.ListTaskChildren)
+	m.HandleFunc("DELETE "+options.BaseURL+"/projects/{projectId}/tasks/{taskId}/labels/{labelId}", wrapper.RemoveTaskLabel)
+	m.HandleFunc("PUT "+options.BaseURL+"/projects/{projectId}/tasks/{taskId}/labels/{labelId}", wrapper.AddTaskLabel)
+	m.HandleFunc("GET "+options.BaseURL+"/projects/{projectId}/tasks/{taskId}/tree", wrapper.GetTaskTree)
+	m.HandleFunc("POST "+options.BaseURL+"/projects/{projectId}/tasks:bulk", wrapper.BulkTasks)
+	m.HandleFunc("GET "+options.BaseURL+"/projects/{projectId}/views", wrapper.ListViews)
+	m.HandleFunc("POST "+options.BaseURL+"/projects/{projectId}/views", wrapper.CreateView)
+	m.HandleFunc("DELETE "+options.BaseURL+"/projects/{projectId}/views/{viewId}", wrapper.DeleteView)
+	m.HandleFunc("GET "+options.BaseURL+"/projects/{projectId}/views/{viewId}", wrapper.GetView)
+	m.HandleFunc("PUT "+options.BaseURL+"/projects/{projectId}/views/{viewId}", wrapper.UpdateView)
+	m.HandleFunc("GET "+options.BaseURL+"/projects/{projectId}/views/{viewId}/tasks", wrapper.ListViewTasks)
+	m.HandleFunc("GET "+options.BaseURL+"/tasks", wrapper.ListAllTasks)
+	m.HandleFunc("GET "+options.BaseURL+"/tasks/search", wrapper.SearchTasks)
 
 	return m
 }
@@ -423,30 +2097,191 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RY32/bNhD+Vw7cHlJAtZ3MAzb3qW3aLkORBEm6lyEYGOlks6VIhaSSGIH/9+FISZYi",
-	"OT+aOE63J/8Qed/x7ru7j7pmsc5yrVA5yybXzMYzzLj/+o4nR3heoHX0K9bKofJfeZ5LEXMntBp+tVot",
-	"99G3nw2mbMJ+Gi4ND0urww/GaMMWi0XEErSxETkZYRPCghIMtjIuU20yTODP44N90AbcPEfIhM24i2ev",
-	"2CJi77VKpYifwbUKCbZwMB1EUChxXiDEWllnuFDO+7OLKS+kC1bW7tMXhVc5xg4TwLAmYjV0bnSOxgm0",
-	"wZEE6ROveJZLZJPxaBwxCiibMKEcTtFvz9BaPm0vZU4nGpR2kOpCJazeZ50RasrIMYPnhTCYsMnfAWtp",
-	"6rRer8++YuwI5g/k0s26blrHXWHvCshxWHUTt9zch7ePl4dG+18dTMUzf96MX31GNSW3dkajiGVCVb+3",
-	"7zqyt7EC+ITbb13UViZb4L+NCF0VUvIzCr8zBXbwo3uGisCrcEXMCScffdZgpPew2n30DFk784/Q6sLE",
-	"2GDlImIrUxwb5A6Tt/4RNRXu2IQl3OFrJzJkPeEVSWttUYikb9l3kSdiRZ48zKEbOfDOeOyocbim3b78",
-	"HNeUQVVkZEd/o01q5stx3ti0dLWfvt8R0Ucz/p4pyQML9u63+vnK6Imyvjxe5Uh9iIdwoXGiBh9ODnYP",
-	"WMT29v85PDr4dPTh+JhFbPdg/0MvNb6o3OiY2vxZiMd6i/4vLkXiLULKhcQEti5RytelSjBBOERgMePK",
-	"iRhMIdH6tX44f/EBefI50AltwPkvtP0bR6O/hEp15yDsHbciBq8TGomvGTphJ/To7fIRvD3cYxG7QGOD",
-	"he3B9mBEzuocFc8Fm7BfBqPBiBjP3cyfeDirZcMUfQYptN4eVTv7hK4UFlQ3NtfKhqjvjEZPRs8SoYef",
-	"x2guRIwgLFQNlRbZIsu4mbNJqXrg/Qxj6ruOTy0VXWnxlBYPy/K2jUPeHH2uMMoClxKqxQMW3QjFZ2Hd",
-	"YWXqkdEQDrM7GVeV1ZI13Bg+741TEVPTSAsJtdNhQHjxvAnd3EoTxa4V2ipRdW5Oacxo25Od974BAweF",
-	"l5UNuBRuBry6MlCf6SYsbKyiGPo+WvdOJ/MnC0hDBfdEZb/hcc7nUvOENecPNaRFh0rbT+bdLa6Vj6Ac",
-	"b0SW8ROW9K33UfOQ++h49Pv6vaqiQUQCLg3yZA54Jazz3X68s7ORWRzuxd4ppzXYmTZuKLWavnpJxd1X",
-	"oCtqvNmOh9e17loMHbffVjfoj0I6NHA2hzCrgatkSGyhUQjnBZr5GzBoC+ksWG3I07M51HINyFx/Rz/x",
-	"wDQRDc/QoSGXV+OTn1BrQ0EPPXx1d5gsheP9gt6UFYuo0/u4xddCWVRWOHGB5Ykp3VyoVS6ct9DvUCTX",
-	"vSakyIRrmanZ9iupHH4lMtK3leYJv7a77z9WAeg0tbgCoWly1GPy9Dmmrxebjxi949H4GftW887+ooa+",
-	"L2wQCnhfawhl72f/bfVXHXNvt2I8CdglmZoXuPZ0bfLrjosr0eoeCoRcflP1oTLUFpwGuuiBSEFnwjlM",
-	"VukRz6u1iZHA2n4l4nvXJmTIKqfo/x9BgGyqkDcrOzDL3TwMnAiEuqB1Je9fpvzw/L6r09yuQIbX9LGX",
-	"LEITkOiw2w52/f8V4Nkc9na7xR4W1cXeqqxx16YvhQCYPBvpPKg29RXlRU6RVrT7Z8ctl3rgYIWaSqy3",
-	"d95v9OdotPbut1Hh8EOk/hO6TpW9SOUQ9ZbzKszQYh4tVYoe0of3pKAVUnIzbRBSgTKxoNNGCbVroPFy",
-	"dT26pAGwaL+A71ce/+fa2+zMb495uOCywBc17EuCl11hK+fGCS5f9U76xeLfAAAA//9c7ntUhCEAAA==",
+	"H4sIAAAAAAAC/+x9e1cbObL4V9Ht3XMGdhtDSOYRODl7CDgz7BDgB87O3d8kF+TuMu6lLXkkNY5vlv3s",
+	"91RJ6ofd7QdJSCD+J8F2t1QqlUr1rg9BJAdDKUAYHex8CPrAY1D0Z7vDr/D/GHSkkqFJpAh2gnOjpLhi",
+	"IExixszwKyZ7zPSBKTCZEhCzG1A6kaL4XstMRdBi5yBilhjW5dE1SwQ77G285ibqMyPZgF8D42ykEgMs",
+	"kiJOcD6ehkwq9+yxFFC8oOCGp0nMDb4W8agPMYvkcNx6K4IwgPd8MEwh2AneBk/fBkEY6KgPA47rMeMh",
+	"/qCNSsRVcHsbBocxDIbSgDAbZzBM+Rji6YW/DYzK4G3ARn0QfmVDKTSwRNNnbaSCGL/OUoPL54IBV2kC",
+	"iin4IwNt2CgxffswHwDLJ47GG7/CmClu+qCY6XPBOBMwYlKAXVEBP4hsEOz8TuAE78Ka9Rwl4np6AWev",
+	"9tlP2z/9xNJEXGvEIcLRS5Q2IRsquGFcxEzAe8OG/Ap0yBSk3CQ34J/1i3hzdjSF5mxr62m0OVTyXxAZ",
+	"/TfeM6BewPjvN61Wi36EXRzvxdsAZ5i7Jf+90ZGGpxv7MhNmei3H2aALCnGs5EizAVJFIq7cilIDSoeM",
+	"R0pqzXia2gW16uZMhIErUMEtzjrkig/AuAOwh2uYnvtkyP/IgEWZ0lLtOrq3FIDTsJ5MUzlCaBLTYu33",
+	"UZrFoFkXelIB4Vj2ehoMgpPggH9koMZBGAg+QIgIdRVQB4k4AnFl+sHOk7r9fklDLw/pUEEE8RSkBMAC",
+	"gNoVLQXpvgJuIG7CrEiRpehrzSL7IOMGGYCFyPTxoCUDaAIoKo9eBqsn1YCbYCdAfrGBQwQzoGtE5zR4",
+	"blMXhezlNMYWBK0tbiCVwxqoflN8WGxpIpDryC6eQhZxpcZ2ezX+jH8OwPCYG84SoQ3wmM4QEQb+ylmX",
+	"I5EqxcdNawEPSnkZMfR4lppgp8dTDfkKulKmwEWVy0bjX2E8vZD9NEEOHPWlBsGuYRwynUV9xjXj7M2b",
+	"w4MQGaOhq0JX+JHmPbB3glHjFut4tlbluhzHZCoTepclPbyIdBZFALEOCUFldn4NQ8N6UtE0kRS95CpD",
+	"3j5KRCxHIeMs5mPWHTO37pCOi3KXB8ICN6DGFqIJpn8N4xY7g0x7hoVg4VycxUmvBwpEAXmPJ6m2Azzb",
+	"3maHB+3Xpyed9vH+Py9+bf/z4qz95rx94Gc3drdH/SSFgrkXaDJJmhICKsNuPZ8a9vD44vTs5Oez9vm5",
+	"ZfREB1Y2KAhh4vaqXlL5zfBD70m0zZ/DxtP4WXfjWe8HvvG8+xNsbEc/xt/Dk94Wf9oNwmDA33vOsf39",
+	"9+FcTnLYI1Gg4ZTy4TAdV2gkqUoj32lki4RqlHNwz6UAJ7NoKMk32oogRDCJZm+Dv7wNWuwEL+pRoqEy",
+	"SRmtT7bZ6Vl7/+T44LBzeHJ88Wrv8Kh9QDsV9bm4As2ENHhrzUKxE5EacOuFmzr0oLjUgKI9oUeg2NOt",
+	"Z+xYGvZaxkkvIeo2fZkZ5AIyHn9ilM1cZCHbLb3So2SQ1EgIp8gNdfK/jSw5pfdqedj3W0SPyQAFre2t",
+	"LaJG++lJOC04hMEJ3ZM1IhcKJ0YyfZ0MW+xXz1TgfaINntSIWJ7exZu4B5bb0F236S4We3XrEM901Gex",
+	"RJqh4RDDCobAjRWBLE3hjWRGAMKTpC7hfQIB9nKvx0B5yVu1S+5wfX1QLPYVCV01TJ1r2EiEBqETEiVL",
+	"PyNjNTwRugHA8kjLCBkImoWn/X6oQGsCZRIy+wSD/JGQuAaeAymYkUOvwEiSyXOpElpXLaYNN5nG23at",
+	"c3JwErISx1ynM24SkwL7D3sbxDBM5fhtQF9nw5hEFMOsUMw2foxb7FUCaax3/LBrL0L2Xy9CloiQ9jsR",
+	"66EbEMcoo9A/+h8iLI9QllwJqYjCuIaQ/dd/JgbzkpKZAGrNyvGhhS564f4A//8Lxq9wAnyPoULx9OnT",
+	"5yT52DvRAE4yIplNOPGR9ZQc0Lf+Ot/4MQ7ZxpPtPj731yej9Rbbl4NuIsDd1CJGDmLhRQCHXIEgLqN3",
+	"2R+ZNMBueJqB9ivGpeohj8Bx37cBDv1di7WVkkozrgBPi1QkU2p2ePyPvaPDg4tXh0ed9llxQUcyzQYC",
+	"pU46CM2Hx5JDlSyLG+zJlmMac+n0XKoavrEvBwO+oQH1EYRYS2VQTEDy41GfyaHVjtMxcY7kvWPebIPI",
+	"AAcDQYK9VDEoR7Qb+a6HREzIkcZ6pyCGsKAER2+hI8kWO7CsgdjZRv5Yi+1bDsUkXrsjqa4LZBLUpg9j",
+	"NgIFLNE6gxgBbEYrvlJB6pAbAwqf/J/f/7rx7m9rObD/zoH4N4H6bwvp+lq44IPrf/lz0Lgt9EgTU7Pf",
+	"s67VBnIUnVl2TNYMtFJwMUYeolEU5Clbs8+9QIaBp2n7B/fFwclxe73pmrLPVHCSGBiQgvpnBb1gJ/jT",
+	"ZmHD2bSP6c1iFcFtvkqS6/NFdhAbCzNuy3/msOw/lmLUb9zGzNUG3Q4upw1m5dHvoHI56BbQBj14i2qD",
+	"WWXkpUG7DQOvqxAZvOTxmb3t8RPuEFibCd1nEUeAN/+l7SVYzDWLeohtEhbKYwyV7KYw+OtyY53atyzg",
+	"VRy+5DFzoLO1AU9x+RCzv5+fHONG48LZINF0nNaRjvel6KVJ9OAW6uFma8iJQ5aJhCwzUmijeCIMrc7x",
+	"WAvTA1vhGwHvhxDhMQA7YxgcS/NKZiJ+aGs5cxoPyR89WgHyAzFUMgKteTeFh7akf1hjOYqMqKRCzNZG",
+	"kKYb7sQ5bSFkGgZcmCRiKkvB2gnWCTo3h+U3JuqXOM5QySEok1hu5IbS0xwT7TI66274J0Lk5wOpzaSV",
+	"xelebG17q2RjsVfkIncfAXiedT2MtySLHdpXn0zehmFgFBeaR1amqrNmTZBHJpx5p7Qa1AVQHS6NFZLp",
+	"Wapczw9q7WI4QKIgRpt+jrzCrm9teQimw7vl/NOIj+RgkBhT57v4rQ/OsQC58cFpN84GBnF5MdpKbGgF",
+	"qwM6DCwN1ZnRJ+6mJffLre12eo9yOOsmncBigYnyeznUZRAb8VwinylMo3Wknrzp5sKfC/TmSG3h2CX/",
+	"Go+9k+u0MvrEdV8DYFKzxcd8AHpySqYlS7kBhaSpWcQFc4YGWTF5tiq+HCc5B2FF+t7b+P9843+3Np5f",
+	"bLz78CT84dltjfgcBgMwfRmX/VQ/tztBGJyenNN/b+jfvc7+L0EYHLSP2p32tBMrDN5v4OsbN1yhzKRx",
+	"HNqWn8EEbodOpS7+zoo/nRWJPhxACgaCd+TbMf06M5HVNxmJaMzOX7t5ZQzlfq4qjjZr5cgyZTrsOGhm",
+	"E1/TOW+mvjLh+a0NnR2uj6Z+ATuo/RKVkhszMWzkvgitod1jwNs3UVf7tHQ79ZxTceaf61wX8tDUIjBL",
+	"r1/LGCp8POBGDpIomGTl9mtn+NGOq+Oi7FVJvFvALuuCNu1ez6rhMNRshJ4I7z9wzlBH7/lUxUuLUrgD",
+	"fc+P4L94WRrJLREVuJNh+ZzZYxuETr2gtRL1h4EG43TBxQE5Ge77Ae3HN8O4/PHAD24/nhdTVCB0qKzT",
+	"n6DAdMsZIZgh584l6lWXu06x8l+6m+tyl+Xr8T9ZyrhsuTdCZpdOJ7v6MIP3PDLp2NuuL5NYX9Jzl9am",
+	"c2l3c+JutVPPu8gsinDhdEnONh8UlkemIYWILMJWoXRn2HEZOpmJYGmiDY5NjuzlrE149qr3ca5pZlkS",
+	"1zLyWRKTHM690wsarRzxJWwWiMY5bxzDyGJ7glPIYSNvwOcbb/aBYxzzVkYMhvDgCHgJYWfqaNzSbnpk",
+	"u71swv3UQnMAZi/4c0mNIIxK4KMExmWRbgNslsf4Gb33scIlQRsuJGMSlHO2ha6nyU0BbwFYQHOsFwdR",
+	"HMADFNp7/lrIkcCdmXvsExHD+xo5SerElKK6ijsyEWUXaGit/VslKijt9N3ZRhWaXzqdU+8sMW6lbCSz",
+	"NGZ9fgPsSjqvA2foZU9z8CpiHHn2poFchO/UMh2LOlplDnrd7u/78X4Bnpr+9PbHYNCR3CxsGZVBzcAD",
+	"0JpfQdVzakFGVdsH5m2HbMSFYU/r9n8xTu25dL2AVrfo3KhV3Un6mvmQkha7jGQMlyyxoihaWtiAY2wX",
+	"bCjgMX2RxCAMeqyV3WSkADoxLOKGp/KKrZF3Z6/kdw/Z672jVydnr9sHF2ft//emfd4Jcy/Q6d7Z3ut2",
+	"p30WsjfH529OT0/OOu2Di9ftg8O9i84/T9vhhMOo/Gpn/5eQ0X8XnfZ5J5/v5ZujXy/2XtJQIXtJD+Qf",
+	"/etn7Vfts/bxfjtkB+3T9vEBhWD4IY5POhevTt4cH4Ts9Ozk7+39zkXpq87e+a/lz/84bP9W/lwasPTt",
+	"0d7L9tFF3ch7r9sX7f8+PO+c+7HK37j3yl+VJtj/5/5R24H08uhk/9f2QVgX/hA2RrDMCEEJmduxi/29",
+	"4/32kR3nuNM+O947umifnZ2cre96XzrTfeIDXcUFespIxynHVUrBLt1BuWyxZ1tbbABcVAOKIhoCrX9d",
+	"QOejhjik+Bv7aGLcl6ybGdZVkmJX0WJWKzvKeOJITlFn3UEsMYGFbjk6SQf0Ut0VV8sbrE8n0Sw/xDWA",
+	"GMUjOKyTD57uM/qRHR4Uaqe7BniqZRET7C4Jeto6c4twK6vMkQieyqsr66ClJy+S2CJ0tlId2RvZr7CR",
+	"+zjkTLHbHnrfaxBTb96oQeNMBE4AayebDW3TvVAN1W7Sw2cRyeTVM2UnPgW1EcMQRIxhXS6WWYfofEZy",
+	"H7N8UIbaYiuoAf9zXSF/11Kc1kc0kUWDfmNrGJbww/Ot7XUWyygbgDBFZEcirEd8YVNyPmdFXJ88XDVP",
+	"TVOZkoMmwGUijLXKWb85OR9kjw3kjdVhKbK9jh5lxQTAYyt54nv0xzDlEf7lvsBhcBTQi5ok8pXt0dD5",
+	"xzM/R+kbP1n+1euJR/bt9PnnDsHRaJur4MZv65N1jyaKAJkQRnlkNJNiwlqX242m0Edj1EvPdniM4o/j",
+	"kDlU0mYYa8ydVjlnmPWOeBfS6Yn2WIo/0DRe3f9OezmmVXOXpPMVA5prn568DfOgaLOof9krFHNVBevN",
+	"rgS+fD/fEOFWebjYHCiMz80BcDHZPtoZSYLQ2gpmh7GFeYyAWSIuoCLyx0F5RQ4nodunMvjljSjP20gs",
+	"+36rJ5xPP79kNDrekn9S6uqq2w196gkaiSSGdkZ80qj/p/jHp/wZrxqs//T71sZzvtHb23j17sMP9QZ9",
+	"AqYUSJpbVMW4bPSkTzxNF+Qrxah79GbpM46BzmMY5Yfmow/BXUh1YqsrO1u3a8cwOrWUMA1yzfxPtn+6",
+	"AwANE3eczjqpR5YopzL5T2QyFFmaWmd2RaEsnVUS1epkv9d5ED7p3hx9JfSX7Ln4l/yDC3t3p2QhI8Sd",
+	"zIUkslWXub215CbbQRqQ/I8ERrXUmA3ENL6DcsRcJZiuGnO5sCiC0+/TZLPNs2Fgo0vcz7i1FWP0spbj",
+	"GtrdXuA17SIbv0AE30KnBgPED0VPTu9o6iPKp+8LzF2zEY91rkStyYfWc7LcFdjIhjylrsX2uhqEoQBj",
+	"uqS0+6FWtGsIKrfB5rmPgF9BWHHmORXMiutFBtf0YjDnb95ifEhbsRp8K5GZrl2RTThpXJLBnL5PmM03",
+	"x1TrQ/zttLVE4CJmapMlf/xp60emYKgAl8i9+ZN0ybDQbbm26kVN6E6RK2qtE4wEFQzRTShgZC+K0GL+",
+	"qY0GtV7WT2xPSIQ2XEQTQJYd4zO4ev7Cs+3tWhOs5+X3YqqosTrUDj4eTsCUKbFjZCx33J7vLLBHkxcO",
+	"/hrm9oZcU6H9b6DYehHjXuX8+cLLHWTrMHAW6un9OxSRggEIY7MzrJ/e+qR2aTMpI6nqNq1I/0/mMouk",
+	"LL7XiuoFfDM25tRZh6qbk5+4hY6e3+OaYzd0w8983d9sU0ukyd0gM9bgpUmepie9YOf3RXwh4eSS3S4c",
+	"O+KZjlby24WS4nc63zVGgdWO6dpkAhh0IX5R2tbpMzWxlHfhlKKN05ALHWJ/l7gRdWti7Z9wDx1y7nkf",
+	"zwtO6/QzeU1yYZ/MfuOasgFhcG4leIrryA+h1/YiriMew1QAjfvexVvkAWhWI/eZIIlyGTyY6CYH0oCL",
+	"ojF9GIRo5XK3/YBlQ2/esW94IrHjx7SL1XCbAjI39oIaqFuu3s/f99+c+nFcnkRNCFYqo2uIX9bFYeEI",
+	"oc3DHhcRHJS5TK9Z9UiKQnbyQgIlXgNFHLXYXuzydxiZ8xJKzi5ZZrvZwCLQu/XIN9+ViHGKESEkLR7u",
+	"MUmgBKtebn1+ZXaheuYCPwq4O9x1H60LL3hZkuGpBm9kj9BeTrau+e7YGtEXxdM8Lkxz1HObJj3exwkU",
+	"e2ddr7k2v8sQMzYXXg43UriB1FFYjSY/36Kg5JUCvdByTv2zS1sN789+8AXFHGKFd5BxyubKabnzDpJP",
+	"QXqN9m1OftmRKNO/Lten+CSm7iXl2QVMu3ONfrj216CuYJZjih4ou6d+fPr8h3Vr9KddZK9RxFGapdAz",
+	"DFPw8X50Vyc5InYZtwexkj+dAleaJSb0v/qDTp6jkn3O3alGDhmd4DqV85NaC5dmDPdq8qvdxk8h9X0Z",
+	"ca/CLKeDpeSI9biqimY57VESjmU0MQxNP8wjqCYkcTy59Cpd9fSQH81LK7koRznIKEaS1OIeo6IQvUQk",
+	"up9LNnIIlLstBYQNIk3BLngX3aFJrb0klqJGzfASHYorzvaKybb15rAG49RemhYLnW9zIkBmmZyIhIGr",
+	"qP9LUqPG66g22/QMUrjhIsq1JjKS7bJ+ctUHhZd2F4wB5TYNyYsripZCIdzlJmmatnJvxzLrliMdBJni",
+	"6ECKZDissz/+0nl9tAE64kOIGbyPQA1LYnr+JOMKM+cKYFF6BzXQIRtwdQ1oAmdpcu0yi6eEIMu4+lwz",
+	"Icvj1kaELBO1V+IbMxZGs9ODRUJ7ZRlspPhwaJ1utloCLov+AiqCUvW//UaV7SYfVJAC12C/3iwNIKQB",
+	"Pd96hAsKHc2EM7wXJb5ZUgoxEz0Ig1KoFebknBy3a/VDShlXUBdD3E/SWIFYik3SUDWs8s7hlw4ZOTB1",
+	"eLAB+l/cr9gA1yd3HzbMc//ewqo0kok4T+BvcBPSZTEptVjeAIOhGbeYs+hEXAhpbKSMHdcldiRGM5Q4",
+	"c95tb43CG/Xnf/9P2f390+1G+eOzZT4+2a73nX9ZocZu9lyv5b06Hyeif3ErbeU22sDC4VOb4vKpXZMN",
+	"wFxJW6LUkZ8zglE5k9Y0Cd2TT3Nqd/2+TipciJO4sHZSZRx+Y+8pXraNL+jGRupkFLWoyzG2o7w83k0C",
+	"o3D5yLoqRX0CC8/i6VayVwKc27wwQeVccvpjeSnQsgzqMVq92ucXg6qr+vRpHTKLkP2SNpTaQ4L1imbj",
+	"D99bBnsbZTPDx1tXFg/QInJfJiyrRLElISop21EmSpbNtqosZqcuZiX4i48dN2fxzUFl9uL7cw9H8dV+",
+	"CaLi2zcFbFRDInHxEZNVW3QSMXR8lh3fORZ2gg7+tFf8xPZOD0s2pJ3gSetJa8vlzwk+TIKd4Glrq7Xl",
+	"IiiJd2x2vUVlKHVdbb1MTOW70yG89B8uSRTw1WxtGTdXKNPXwU1UHoeufZA6iSGWl7E2Vtu6kiS8KJld",
+	"lcp4DpI4TmHEXU3fPhdxCoqCJoqM6y6AYF59dhJJyLR0lRF9Ceu4cEKL2HqhY6sbUQ0pgtxnMLE9hjhi",
+	"Az62BjTGK8nru3n5Rm1nnohPoLiETKSgp9D3nXbB+JpukRxp+7bQyUZnjCk6lKEO2kteXWCCwk1ab8Vb",
+	"seeg8MhEUMN8VKnyHHVenpgWk1cmqNTWrpbRJufC2JogpMCSlJdJfLnDLv/8wZ2xFk7QSuLbS9T4Razz",
+	"eyqJ2QB8oAnuBT4Zll91YLbQynp76eI0tDW62t8Q+7QCt0oCJMkreVI+CLcrAVTWTalWbQ+Ugtia86xO",
+	"iYOTgdAQYvcqKLEv0CQSUZAJSuLDZUjlV8Kpkqe2tWGnc4oQXdVxKlMQ9K4czLPtZ9MpSLtMQGKpLxOa",
+	"Nvg3BPyyUjHlkmkwtthJ5SyqTPiqKDE3vMt1pTyKpfU8aIjOKi0HYcW8HKmcyUfJNHXJ+Q7NPLremarB",
+	"jsEk7DJPzKQsMirgQjO53H5aTRkJBQqfVbO0ykVeqepe+S1HW8WpzldThmh760ers2iZ+7sHDuNWH8lD",
+	"1/FWtsUfgmod8gbve/HI5kRhZXR+OzBfulIRcyoV5ZdxuXrP70WlCStvBEc8E1Hfyyn+Vg2Kmh++yIcN",
+	"5y8FA92GxWD+krAGEcQY8pFMROT4CG7nD7f55w/uz/ywb5KKR/Pkb//cXurlv5XK8xEOJ6oCOVVmsUpM",
+	"lRJJt1WhxA1UKZOzvbX1ySpKVcsE1dSCak/VLionMJcqfTT0Raib3L21WfcKgbC99eP9rfAcj1uFF9kz",
+	"t1sUdC1lrH++RT/b2mp6N9/+zVL5Pnrl+UOrLba3YHeLRBclyIWT7589+f7hVbrT2dCVki0SRgl+ushp",
+	"WdvbSy1LClgg0mqC7sOF0PHusyOkwih3JmQMJ15QaxEnJGGQMp47tlZZ0Pouc5IaSf5ILokgCTkkQTcB",
+	"zYyUbIAycbWOHPmSMg0aBaVJslsjRKzbOkZ5RNNDr65IVSsGA67GriKdLzO7d3rIyuoQyYDWE6OSIXmw",
+	"+BVploTmgOhjs5+nnF7V+Xz20oSTN849uJliSdjrpoLmrSnJ5mfwKaef8eZzM9RdCKBukohkMh8CV8Wg",
+	"fZXt9yG6LqHIjVjGES29EVFnVGQa60MVtVNc2UicPBu22DFuFDMyIy0qD+lKoB5tR8kNCND6yyDutICd",
+	"08KraPPA4Rq7MAdxCng8Xgpz2m1bxIUt3mQU7/WSiCqlK5MNyT9ofcqoRJt+SdXwgr0XzO1iaSXGpV8Y",
+	"0j2TK1eEqkYi/xnMGfA4+XI7UCJdi8DbMPh+6+n9zoyKvpu9yng8bmYTQK4ING++Ncn4B0NbF1RBBAKL",
+	"dfkKy65bFfI0anfDUd2mUsvsFbVdoo3F/le+rIC7UnyFEdvEgiqWG2nHKFl2BnUkcJRoc1qu97eMbma7",
+	"U9yGcx90LSQWeNIWtF7gQVdfeoEn8+5Ct+8+ksYLQeYjI90Xeo9Cdm7f1dEuajRa97K0yA6vyvm+Sdos",
+	"wZ6eqetGNuul6sNlPeAhSRyHVvRypsXCnp8XsLbWKHuY1h+vaIWnv5QoUDA4/50tYFBrorZGdtfJzz3v",
+	"e1G5CuC+iEaV5dgXT3MDyz3bgxbDaSnfuQatx6UlD/k4lTwO5ltBnnwy6GaA5n5iRZ3bmq6Xs044PdPc",
+	"N/JjTAUPrXq/WqZ6/wO0bXhasf6QlGQgq+5YtZOvbB8fa/v4Oqvj22uOth2NDrovldlMpbhap32f3F8y",
+	"P8RNbfwe7/VYd8U13JJlRWDzQ+6Tv7X3ZgoG6moo4Pe6CFwhbQ49TYnRLg2FdXyIr3Xu+DmseyuVGqqZ",
+	"U1KU+tYNbCSzi7T3YccYiVzvobEA3flmdk0La8TsZzU5+G7JLvfNctBnD5aDlntpPHvy4HhE3q06lrZ1",
+	"o2MOfai0RXy8R/3AldSec8zDWRp+UZC1NMiUxeXux6toevnRmuwdhcv5iufiEiaO/rSOMxxTxKvt2rk7",
+	"0RvdCykibwhRIc+PAOWxMJ/HeTx/BlO6JrtjdnjQrK5Wj1U9zg4PfPsy8qHn3cvK0XRVfa62k1l9fCEe",
+	"z2FWwydsCFqlGl69jlwNmf+4m/jTK8dV6O45AuAe2dO3obY+EqHrUanfj1+MXNkHvlr7wOMVIybv3/z2",
+	"XUKj3yyKYMz09tmQzLwCbqXljauNERZ2NdFU7zWp0SLQbG9Lbnys13YhP1ZD5Y0F79+VbP0QnEB5WWFd",
+	"KddcPhv2569JxK71S+3FMZrUCNrQO6ToGnJeKZczXjqQTMEVVzFlDcieaytvZPmRat3qOoeWPSVfrzvL",
+	"neLb2/t0VJUmrakYVO+kWjmeVhL8gwkOtve7u8YTXSfLsyqzWXnWHr9nzYX22lr2K59arU8tb2IwJV/M",
+	"kbw3P9D/CzvX7BGlQElKWEswx7fn5HNXZiEXtm2hHlexseQ1a3aX+Xt/vsuLnnzgDi+7BqnYsI6FP3Lf",
+	"UDPJ5n6h6QjnevLY+vwi1iPSxr5FqrMuj1kk9xVoYWE9j2ua1DHuz+JbOQPrFKR0Gbp4c+bfYu1aZk+l",
+	"PnSpBaxLdtcUgOE7SjbHS5QLLn1OT8sSqtsX5SsrXevB8c5vROla6U0PUG/6BvwQlpLXhlyZhKfry6lD",
+	"tixAkx/CVYbqjl1x0ND3aaqUscT2iwrsXkjlUpEo6WjX98ekct3aEmF3XCr3hAP5Yiv4O9N8rG2c4ijR",
+	"8BkzmXYZdb/ZtA2A8He80G3Z/ZFU11iHqSHZieIpgznCE5UZTckuntf6l1T9xJYBbHnxhhJICvnGyOER",
+	"1vILygJNTsJUrmO6f/xt2Lx1lj6sr2jaSN1iZzAEbhBH+EyeJrtG773oZldYkmv7B/sxS9Z37YjWSUvb",
+	"5TMSbcFcJgBibQvzKApFdZGkFp11a055d2LBuVNpTsmuyd7/TS36HWiDTBsrvU3D530Xtj6cR1xrFsiv",
+	"XTmSJaQh+8rtAjlnRdVDu5fBgu9Q2avlXinVxVr8RV8hq7fc84sn3fliW4tO4J5ffAJcul1vO699t+h7",
+	"WOdtkWdX+Y13qQc+/6VVZuOXzmx0VR2lykUuWxlyfeWufxjuep8VUuupp1+/ekd9JbsGQd71ZBmXmoti",
+	"xSqsOSht3bMm73vH1sf+Wp3vrqZ3bSIpCTdfIou0CSj8fpU/ujItfUNu/JU3/hFblcDWPrfWDy/vuIrk",
+	"K998nW+erqR50sVsu9TmB/xvISe9n5DSesKiemzez8fbmNznF77PYt4Hqtxa0XXcRCnXGmtquy34xNrm",
+	"NFpeact4p0zauwklPnmnxh5CvYmGQxDaL6jc82iqlWSD+cO/s7DxY7J55u1iKb4kRzzscAdaQqPvZJXn",
+	"+3BjOfwBmdaZFsrw9a9PxXvc8czfU25vk8i/Sux9MFznMUe7FGLAV2vOCGtvuaY5rQz00faT+vab+zZm",
+	"Zcht1xKHPpfxMLM3p/IPlH764fnW9jqLZZQNSGgiaoDYCxo0Ntfs53Yn7zGRUMOGAU1CMDINxp7egev5",
+	"mdgbRoe2tsmMNp84VAkmldEEuuBHOm/Dg10gUMRHp9+lAW0ui6eYAl8gxQNFGHm29ZydUgX8Tvu841oA",
+	"uIaCDY5RZ42KuKi0qbWFWPwCsblDFxhCgZ6nzJ5W+3zcYtiCeNSXqYfFYpUsr75iMF7FdTIk4eHjRMil",
+	"DFobBOFfp+rm//4hkMNgJ8AllmrO5/1fqOcDyhalTnu3oXtJoR0nglnvUU++23flMzGLx/xdS3HqHXGV",
+	"RRAhNq1i4vAgHRYtzBwM4RK3aKkv7j0HZt3XHb4yuj1AleQhGt8MblfBwL3xZopbW2NNfhm5Fj1ULLno",
+	"vstG/cTJ59q26nexnrbpvGGxFMDWOnvnv168PDrZ/7V94Kqnr/LtV3bKeznAuWBSNJnywtaa73NExF8Q",
+	"/NA35yUBWQHHjtFMZSmwNXphr3N4cuzPyWPuBmClw1n6+6zKO3j2pWIDqcC3fSw1qn9lv5nVp951aG0S",
+	"YWnbfDdG0IUrwQqENpLM9lfiKnZkYDUAW8sdhU2eammZYvkgEwS6OQz9HiXFZePXC4llJSWtpKSVlHTH",
+	"S+MTSz2h7d5GT1MrCQUMRE+qCOKVRLSSiL6ifABniaEr8FtIC6CTXpsVsKTzddOf7s0P7q85HtkzyJ2q",
+	"FX+orUt0GKNnNB+JJBZ0gtrnc7+o7LGuNP1p/4gdHXnzSzvGQnnTBwUctpX6o/MmVhVb15LGopmi2QuM",
+	"r5XamB6fdC5enbw5ftwC/zn67L1rwN9srnnvw/ESHB54D73fVmPl9c/jNJg3P+IQp0EZIIn63oqNIR9i",
+	"zIrmpzXQ5bT4mbJ4I6liF5dRkD39pT0PmsN2mB3ES0BXPBG5/uWM7uhsKLE3mm0kszRmA+zEVSY3lhgN",
+	"aS9kcaIgwn5NUuXJSNYXgA/r0Dax6lHoEKl5paO6/8/9o3ad7rYXxzPZ4edXiDqTNPlweWtYJe7HJv+X",
+	"CNbSahdYlKgoS7lia5PU9ojlpNdTZ3TGfbCopBT1kzRWIBoTKo8or5gEI+IEldgr7tmpDyC1+RQ6tJmR",
+	"yLFclqLsUWYh0quelaS47+FZ1qZz19yzVTbVKptqlU21yqb6JuOP8iKo00x9FYqUC+2L3qXLFGrrUFE2",
+	"nldK7pXCjXI5v1SPZ5ZVYdlabIZfg8A5H7TQmzZWeKnaFXKTqE2iz9Pm2drR3sv2Ucme8HjPeceKjgWx",
+	"rc74QpYDXlvJ/P6rfJ1mRk8C4zI+PPVLMY+DsNPMGGcekKLBQtCsrH/2kn6zVHVaefoYdPX0Gyzqd5qZ",
+	"gnrFDOaz6E1rFMDcVgTl4xAyYUNVMxGDooqnthKqE3wYN+6LGIamHzKZxqCNbU5OP/Ko73Ko6lvKUx0T",
+	"BfC5TwjN8bjrXn7biQA2kt21w/Ry+eqmXlAa3+lmKen2X38NiNAVFQtdtiCSvCvISSqZU697bIDeiZJ6",
+	"5s9FYrueepc43vr42SguNI8M3fknRR6BykQpl2BibnxZg7EWNMarAoUm2x3E+PplEutLBFWKcjHpqoTE",
+	"KIqGEhWsTeAyxOQF+tPHiVG+AWU35IZBZMo8zThORVEaOGQpxyETusXayIlpTiMzCg+8AgojYyCMGhOM",
+	"robbZSmz3S3MZ7Llqe9kUqY01ytpWm/FW3Eo2CU3cpBEl5i4BWzNuoWJ76w7MydeCz4Zg4BRMk016/Lo",
+	"mpk8+8HNskNf+WsBPSbPtrdDdhnJga3vcYnfUaE02giLV+tlsYtSMJTK4HvP2Ms3R79e7L08OetgKgdC",
+	"2wVt2r2eVMZBzKuwJZrp62Q4BOtEcsDYMJlrGNbAt731o90BLQeeBmyQAvqQPPJoA3AWiiw0fW53LJKi",
+	"l1xlCtCv9D4ZZIOiww95i/5lGSHH/bCIsskq29t1l+vLLL1uqF/3uSudlPIoEK/BTmAJowyjhcTSNp1+",
+	"IrQXrJQYYrGemBTYf9jbQEEKXMPbgIbBd/zBC6aTM1xGiS1MQreAJv5Gw6F0LoTMRARuC2lkxyYXu7Q8",
+	"es98nv/9RiwW09sJ6i5PW8e4YAQaZR2IP2cLle2tH+91icUR3pk+c6UMLM1G4E7tqn/MYtGdYTW285GX",
+	"/1oVovkWwxkLr9tyjChcCCPvPjtO7LW643UgFw+J16bPFx2htODzRSYXsr7rTb4eoYn2hzx0cqJmRsqS",
+	"LE1WYgWZBhIeJ4ltjdb+qGPMzjLhhPdKsjFqUEsZZ24SGC3aGVLzG4gZvtHUHrK+8eM/aI776PuIM63a",
+	"Pj5+j2dOiM29H+nXr76i5Dm/IVciHWXUpZFdWjvnvBaQLa+Tk/ovlSHfYHED2+wrVNSK4J7i2QIpZZ9E",
+	"DgI+xxWwqA8UZmf1SUR7nZZnbSJ0+L7egpaWN9xvM8lizon0AeShq1aSqxqUD1v0J1Fg1UlylQFVlwHl",
+	"9PNVucpyZgjaqjmdmzpZZbaUvvkB/5uKTaor4+iu4vlBRfjgAy94SEv4Rts7FoJwvejb1OKxnj62Prvc",
+	"84j0rm+Q7Kyjex7NfY2ObdqspjktV/0ssV/7LkILZZyr5AZEpYQH4ZDt+QIdTkWTympoRY2OhvIZ+SH+",
+	"XEUwFteYviTnWOk4D407fhu6zkpdeYjqyjdQqqFkxq8t2LCkNjKnqeNZ5sI58fHv9JQhkkKZnV3XzfGd",
+	"dh50fEgVEaEDlibOwlkxVsYSdG3rxoZcRWROdwtKWSUErhICH2dCoO1dur5Sxh6cM6wUXLpSzRpijmdf",
+	"UUWafI5L6/AuW62rHm/t1LlEsBwv4YQ9u5JP751qLqXeeeWGoDY8zbrbrMV+w1Fg0IX4hf8N8rhZqrQY",
+	"caUSp1aSICp7FHGew6sl2lW57nclV7FvkTsRP9t0Pe6l6bIth8uoM33QBZ7KDX8RZb7hb1OnlzKV1Tj/",
+	"5+z2/A69Z5ByK9pqmakIqC1NIqI0i10ka47rJhBpa+rBA5EN8Mi5VQTv7gDhqpPzVCdnRvlrmnUhlRgX",
+	"LRmfoHWCgDRmdyxcd2UagbuSeomonmsXGb9qFL1qFL1qFL3SZCbkMuQQd1JoSu+u9JovXuhEKqyKPW6I",
+	"HH/0DZd5pKTWhTjWEBtKf29q4CrqN8rJr7I03TDw3jD7IJM3rpScTZCxBpvSOzr0eW55prvyEFXu9zOb",
+	"80UhZ4oLV0NSQQo3XETA1l6+3v5+3QvYOFd+2Y8guZrIYypB4B8LXalyX0Vi4KvT42oKsa+fGDZSnLKt",
+	"EsFQgHkaDbi6pr+AIerqxOZzwsdCUrN9lBlQA435WPb+94vpo05hDa48yQNv8eFdNswMOzljXTAjAGFH",
+	"QFmIXmaQUDkx1qamRfijXddfikcSY9O2hgp6yfvQ/Y9f05Mb+CS8t6KwpR4Hhy08YHGIUIyo4GEiWCyz",
+	"bgrsj0waKMFCtjo7VV+hrEgoyxOyghiGqRz/pUinYgIHeBuwjVjxnmmQbf6YqQgO+HsvkW1vbYWzJbQG",
+	"sdvR9YQ+k+hygOniWssCuvCnvqXf3UeUNckZhKlfCLq54davfUapC97vgnZppjYbs3rxrS6zyctMyJwy",
+	"6dAngv2xvopX/+qjvdyeNRUBuL29/b8BAInA2E7wKwEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,11 +1,12 @@
 package api
 
 import (
-	"database/sql"
 	"encoding/json"
+	"errors"
+	"io"
+	"mime"
 	"net/http"
-	"strings"
-	"time"
+	"strconv"
 
 	"github.com/oapi-codegen/runtime/types"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -13,8 +14,12 @@ import (
 	"full-stack-assesment/internal/apierrors"
 	"full-stack-assesment/internal/helpers"
 	"full-stack-assesment/internal/scheme"
+	batchservice "full-stack-assesment/internal/service/batch"
+	healthservice "full-stack-assesment/internal/service/health"
+	labelservice "full-stack-assesment/internal/service/labels"
 	service "full-stack-assesment/internal/service/projects"
 	taskservice "full-stack-assesment/internal/service/task"
+	viewservice "full-stack-assesment/internal/service/views"
 )
 
 var _ ServerInterface = (*Server)(nil)
@@ -22,40 +27,53 @@ var _ ServerInterface = (*Server)(nil)
 type Server struct {
 	projectsService service.ProjectsService
 	tasksService    taskservice.TaskService
+	viewsService    viewservice.ViewsService
+	labelsService   labelservice.LabelsService
+	healthService   *healthservice.HealthService
+	batchService    *batchservice.BatchService
 }
 
-func NewServer(projectSvc service.ProjectsService, taskSvc taskservice.TaskService) *Server {
+func NewServer(projectSvc service.ProjectsService, taskSvc taskservice.TaskService, viewSvc viewservice.ViewsService, labelSvc labelservice.LabelsService, healthSvc *healthservice.HealthService, batchSvc *batchservice.BatchService) *Server {
 	return &Server{
 		projectsService: projectSvc,
 		tasksService:    taskSvc,
+		viewsService:    viewSvc,
+		labelsService:   labelSvc,
+		healthService:   healthSvc,
+		batchService:    batchSvc,
 	}
 }
 
 func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
-	helpers.WriteJSON(w, http.StatusOK, "OK")
+	s.GetLiveness(w, r)
 }
 
-func (s *Server) CreateProject(w http.ResponseWriter, r *http.Request) {
+func (s *Server) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	helpers.WriteJSON(w, http.StatusOK, s.healthService.Live(r.Context()))
+}
+
+func (s *Server) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	health, ok := s.healthService.Ready(r.Context())
+	if !ok {
+		helpers.WriteJSON(w, http.StatusServiceUnavailable, health)
+		return
+	}
+	helpers.WriteJSON(w, http.StatusOK, health)
+}
+
+// CreateProject leaves its Idempotency-Key to IdempotencyMiddleware.
+func (s *Server) CreateProject(w http.ResponseWriter, r *http.Request, _ scheme.CreateProjectParams) {
 	ctx := r.Context()
 
 	var body scheme.NewProject
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		helpers.WriteError(w, http.StatusBadRequest, "invalid request body")
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
 		return
 	}
 
 	project, err := s.projectsService.CreateProject(ctx, body)
 	if err != nil {
-		// If time permitted, I would have logged here. For now just focused on error handlers
-		if err == apierrors.ErrProjectNameExists {
-			helpers.WriteError(w, http.StatusConflict, "project name exists")
-			return
-		}
-		if err == apierrors.ErrProjectNameTooLong || err == apierrors.ErrProjectNameRequired {
-			helpers.WriteError(w, http.StatusBadRequest, "invalid request body")
-			return
-		}
-		helpers.WriteJSON(w, http.StatusInternalServerError, err)
+		helpers.WriteError(w, r, err)
 		return
 	}
 
@@ -64,178 +82,479 @@ func (s *Server) CreateProject(w http.ResponseWriter, r *http.Request) {
 		Name:      project.Name,
 		CreatedAt: project.CreatedAt,
 		UpdatedAt: project.UpdatedAt,
+		Version:   project.Version,
 	}
-	helpers.WriteJSON(w, http.StatusCreated, created)
+	writeVersioned(w, http.StatusCreated, created.Version, created)
+}
+
+func (s *Server) ListProjects(w http.ResponseWriter, r *http.Request, params scheme.ListProjectsParams) {
+	ctx := r.Context()
+
+	page, err := s.projectsService.ListProject(ctx, params)
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	writePage(w, r, page, params.Envelope)
 }
 
-func (s *Server) ListProjects(w http.ResponseWriter, r *http.Request) {
+func (s *Server) GetProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params scheme.GetProjectParams) {
 	ctx := r.Context()
 
-	projects, err := s.projectsService.ListProject(ctx)
+	project, err := s.projectsService.GetProject(ctx, projectId.String())
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+	if notModified(w, params.IfNoneMatch, project.Version) {
+		return
+	}
+
+	writeVersioned(w, http.StatusOK, project.Version, project)
+}
+
+func (s *Server) UpdateProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params scheme.UpdateProjectParams) {
+	ctx := r.Context()
+
+	var body scheme.UpdateProject
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
+		return
+	}
+
+	project, err := s.projectsService.UpdateProject(ctx, projectId.String(), body, params.IfMatch)
 	if err != nil {
-		helpers.WriteError(w, http.StatusInternalServerError, "internal server error")
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	writeVersioned(w, http.StatusOK, project.Version, project)
+}
+
+func (s *Server) DeleteProject(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params scheme.DeleteProjectParams) {
+	ctx := r.Context()
+
+	if err := s.projectsService.DeleteProject(ctx, projectId.String(), params.IfMatch); err != nil {
+		helpers.WriteError(w, r, err)
+		return
 	}
 
-	helpers.WriteJSON(w, http.StatusOK, projects)
+	w.WriteHeader(http.StatusNoContent)
 }
 
 func (s *Server) ListTasks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params scheme.ListTasksParams) {
 	ctx := r.Context()
 
-	tasks, err := s.tasksService.ListTasks(ctx, projectId.String(), params)
+	page, err := s.tasksService.ListTasks(ctx, projectId.String(), params)
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+	writePage(w, r, page, params.Envelope)
+}
+
+func (s *Server) ListAllTasks(w http.ResponseWriter, r *http.Request, params scheme.ListAllTasksParams) {
+	ctx := r.Context()
+
+	page, err := s.tasksService.ListAllTasks(ctx, params)
 	if err != nil {
-		if err == apierrors.ErrProjectNotFound {
-			helpers.WriteError(w, http.StatusNotFound, "project not found")
-			return
-		}
-		if err == apierrors.ErrorTaskStatusInvalid {
-			helpers.WriteError(w, http.StatusBadRequest, "invalid status options")
-			return
-		}
-		helpers.WriteError(w, http.StatusInternalServerError, "internal server error")
+		helpers.WriteError(w, r, err)
 		return
 	}
-	helpers.WriteJSON(w, http.StatusOK, tasks)
+	writePage(w, r, page, params.Envelope)
 }
 
-func (s *Server) CreateTask(w http.ResponseWriter, r *http.Request, projectUUID types.UUID) {
+func (s *Server) SearchTasks(w http.ResponseWriter, r *http.Request, params scheme.SearchTasksParams) {
+	ctx := r.Context()
+
+	page, err := s.tasksService.SearchTasks(ctx, params)
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(page.Total))
+	helpers.WriteJSON(w, http.StatusOK, page.Items)
+}
+
+// CreateTask leaves its Idempotency-Key to IdempotencyMiddleware.
+func (s *Server) CreateTask(w http.ResponseWriter, r *http.Request, projectUUID types.UUID, _ scheme.CreateTaskParams) {
 	ctx := r.Context()
 
 	projectID := projectUUID.String()
 
 	var body scheme.NewTask
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		helpers.WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
 		return
 	}
 
 	task, err := s.tasksService.CreateTask(ctx, body, projectID)
 	if err != nil {
-		if err == apierrors.ErrTaskTitleTooLong || err == apierrors.ErrorTaskStatusInvalid || err == apierrors.ErrorTaskTitleNotFound {
-			helpers.WriteError(w, http.StatusBadRequest, "invalid request body")
-			return
-		}
-		if err == apierrors.ErrProjectNotFound {
-			helpers.WriteError(w, http.StatusNotFound, "project not found")
-			return
-		}
-		helpers.WriteError(w, http.StatusInternalServerError, "internal server error")
+		helpers.WriteError(w, r, err)
 		return
 	}
 
-	helpers.WriteJSON(w, http.StatusCreated, task)
+	writeVersioned(w, http.StatusCreated, task.Version, task)
 }
 
-func (s *Server) GetTask(w http.ResponseWriter, r *http.Request, projectUUID openapi_types.UUID, taskUUID openapi_types.UUID) {
+func (s *Server) GetTask(w http.ResponseWriter, r *http.Request, projectUUID openapi_types.UUID, taskUUID openapi_types.UUID, params scheme.GetTaskParams) {
 	ctx := r.Context()
 
-	projectUUID, ok := helpers.ParseUUIDParam(w, r, "projectId")
-	if !ok {
+	task, err := s.tasksService.GetTask(ctx, taskUUID.String(), projectUUID.String())
+	if err != nil {
+		helpers.WriteError(w, r, err)
 		return
 	}
-	taskUUID, ok = helpers.ParseUUIDParam(w, r, "taskId")
-	if !ok {
+	if notModified(w, params.IfNoneMatch, task.Version) {
 		return
 	}
 
-	task, err := s.tasksService.GetTask(ctx, taskUUID.String(), projectUUID.String())
+	writeVersioned(w, http.StatusOK, task.Version, task)
+}
+
+func (s *Server) DeleteTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, params scheme.DeleteTaskParams) {
+	ctx := r.Context()
+	subtasks := scheme.SubtasksCascade
+	if params.Subtasks != nil {
+		subtasks = *params.Subtasks
+	}
+	if err := s.tasksService.DeleteTask(ctx, taskId.String(), projectId.String(), params.IfMatch, subtasks); err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) UpdateTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, params scheme.UpdateTaskParams) {
+	ctx := r.Context()
+
+	var body scheme.UpdateTask
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
+		return
+	}
+
+	task, err := s.tasksService.UpdateTask(ctx, taskId.String(), projectId.String(), body, params.IfMatch)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			helpers.WriteError(w, http.StatusBadRequest, "invalid task id")
-			return
-		}
-		helpers.WriteError(w, http.StatusInternalServerError, "internal server error")
+		helpers.WriteError(w, r, err)
 		return
 	}
 
-	helpers.WriteJSON(w, http.StatusOK, task)
+	writeVersioned(w, http.StatusOK, task.Version, task)
 }
 
-func (s *Server) DeleteTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID) {
+func (s *Server) PatchTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, params scheme.PatchTaskParams) {
 	ctx := r.Context()
-	if err := s.tasksService.DeleteTask(ctx, taskId.String(), projectId.String()); err != nil {
-		if err == apierrors.ErrProjectNotFound {
-			helpers.WriteError(w, http.StatusNotFound, "project not found")
-			return
-		}
-		if err == apierrors.ErrorTaskTitleNotFound {
-			helpers.WriteError(w, http.StatusBadRequest, "invalid task")
-			return
-		}
-		helpers.WriteError(w, http.StatusInternalServerError, "internal server error")
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
+		return
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	p, err := s.tasksService.ParsePatch(mediaType, body)
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	task, err := s.tasksService.PatchTask(ctx, taskId.String(), projectId.String(), p, params.IfMatch)
+	if err != nil {
+		helpers.WriteError(w, r, err)
 		return
 	}
 
-	helpers.WriteJSON(w, http.StatusNoContent, "Deletion: OK")
+	writeVersioned(w, http.StatusOK, task.Version, task)
 }
 
-func (s *Server) UpdateTask(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID) {
+func (s *Server) ListTaskChildren(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, params scheme.ListTaskChildrenParams) {
 	ctx := r.Context()
 
-	if err := s.projectsService.EnsureProjectExists(ctx, projectId.String()); err != nil {
-		if err == apierrors.ErrProjectNotFound {
-			helpers.WriteError(w, http.StatusNotFound, "project not found")
-			return
-		}
-		helpers.WriteError(w, http.StatusInternalServerError, "internal server error")
+	page, err := s.tasksService.ListTaskChildren(ctx, taskId.String(), projectId.String(), params)
+	if err != nil {
+		helpers.WriteError(w, r, err)
 		return
 	}
+	writePage(w, r, page, params.Envelope)
+}
 
-	var body scheme.UpdateTask
+func (s *Server) GetTaskTree(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID) {
+	ctx := r.Context()
+
+	tree, err := s.tasksService.GetTaskTree(ctx, taskId.String(), projectId.String())
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+	helpers.WriteJSON(w, http.StatusOK, tree)
+}
+
+func (s *Server) AddTaskBlocker(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, blockerId openapi_types.UUID) {
+	ctx := r.Context()
+
+	task, err := s.tasksService.AddBlocker(ctx, taskId.String(), projectId.String(), blockerId.String())
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+	writeVersioned(w, http.StatusOK, task.Version, task)
+}
+
+func (s *Server) RemoveTaskBlocker(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, blockerId openapi_types.UUID) {
+	ctx := r.Context()
+	if err := s.tasksService.RemoveBlocker(ctx, taskId.String(), projectId.String(), blockerId.String()); err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) AddTaskLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, labelId openapi_types.UUID) {
+	ctx := r.Context()
+
+	task, err := s.tasksService.AddLabel(ctx, taskId.String(), projectId.String(), labelId.String())
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+	writeVersioned(w, http.StatusOK, task.Version, task)
+}
+
+func (s *Server) RemoveTaskLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, taskId openapi_types.UUID, labelId openapi_types.UUID) {
+	ctx := r.Context()
+	if err := s.tasksService.RemoveLabel(ctx, taskId.String(), projectId.String(), labelId.String()); err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// BulkTasks leaves the Idempotency-Key to IdempotencyMiddleware, which replays
+// the whole response, per-task results included.
+func (s *Server) BulkTasks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, _ scheme.BulkTasksParams) {
+	ctx := r.Context()
+
+	var body scheme.BulkTaskRequest
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		helpers.WriteError(w, http.StatusBadRequest, "invalid JSON body")
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
+		return
+	}
+
+	res, err := s.tasksService.BulkTasks(ctx, projectId.String(), body)
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	status := http.StatusOK
+	switch {
+	case !res.Committed:
+		status = http.StatusUnprocessableEntity
+	case res.Failed > 0:
+		status = http.StatusMultiStatus
+	}
+	helpers.WriteJSON(w, status, res)
+}
+
+// Batch sends every sub-request through the whole API, middleware included.
+// Numbers in sub-request bodies are passed on exactly as sent.
+func (s *Server) Batch(w http.ResponseWriter, r *http.Request, _ scheme.BatchParams) {
+	var body scheme.BatchRequest
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&body); err != nil {
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
 		return
 	}
 
-	set := make([]string, 0, 4)
-	args := make([]any, 0, 6)
+	res, err := s.batchService.Run(r, body)
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	status := http.StatusOK
+	switch {
+	case !res.Committed:
+		status = http.StatusUnprocessableEntity
+	case res.Failed > 0:
+		status = http.StatusMultiStatus
+	}
+	helpers.WriteJSON(w, status, res)
+}
+
+func (s *Server) ListViews(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	ctx := r.Context()
+
+	views, err := s.viewsService.ListViews(ctx, projectId.String())
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, views)
+}
+
+// CreateView leaves its Idempotency-Key to IdempotencyMiddleware.
+func (s *Server) CreateView(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, _ scheme.CreateViewParams) {
+	ctx := r.Context()
 
-	if body.Title != nil {
-		title := strings.TrimSpace(*body.Title)
-		if title == "" {
-			helpers.WriteError(w, http.StatusBadRequest, "title cannot be empty")
-			return
-		}
-		if len(title) > 200 {
-			helpers.WriteError(w, http.StatusBadRequest, "title too long (max 200)")
-			return
-		}
-		set = append(set, "title = ?")
-		args = append(args, title)
+	var body scheme.NewView
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
+		return
 	}
-	if body.Description != nil {
-		desc := strings.TrimSpace(*body.Description)
-		set = append(set, "description = ?")
-		args = append(args, desc)
+
+	view, err := s.viewsService.CreateView(ctx, projectId.String(), body)
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
 	}
-	if body.Status != nil {
-		if norm, ok := helpers.NormalizeStatus(string(*body.Status)); ok {
-			set = append(set, "status = ?")
-			args = append(args, norm)
-		} else {
-			helpers.WriteError(w, http.StatusBadRequest, "invalid status; use TODO|IN_PROGRESS|DONE")
-			return
-		}
+
+	helpers.WriteJSON(w, http.StatusCreated, view)
+}
+
+func (s *Server) GetView(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, viewId openapi_types.UUID) {
+	ctx := r.Context()
+
+	view, err := s.viewsService.GetView(ctx, projectId.String(), viewId.String())
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
 	}
 
-	if len(set) == 0 {
-		s.GetTask(w, r, projectId, taskId)
+	helpers.WriteJSON(w, http.StatusOK, view)
+}
+
+func (s *Server) UpdateView(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, viewId openapi_types.UUID) {
+	ctx := r.Context()
+
+	var body scheme.UpdateView
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
+		return
+	}
+
+	view, err := s.viewsService.UpdateView(ctx, projectId.String(), viewId.String(), body)
+	if err != nil {
+		helpers.WriteError(w, r, err)
 		return
 	}
 
-	now := time.Now().UTC().Format(time.RFC3339Nano)
-	set = append(set, "updated_at = ?")
-	args = append(args, now)
-	args = append(args, taskId.String(), projectId.String())
+	helpers.WriteJSON(w, http.StatusOK, view)
+}
+
+func (s *Server) DeleteView(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, viewId openapi_types.UUID) {
+	ctx := r.Context()
 
-	if err := s.tasksService.UpdateTask(ctx, args, set); err != nil {
-		if err == apierrors.ErrorTaskTitleNotFound {
-			helpers.WriteError(w, http.StatusBadRequest, "invalid task")
-			return
-		}
-		helpers.WriteError(w, http.StatusInternalServerError, "internal server error")
+	if err := s.viewsService.DeleteView(ctx, projectId.String(), viewId.String()); err != nil {
+		helpers.WriteError(w, r, err)
 		return
 	}
 
-	s.GetTask(w, r, projectId, taskId)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) ListViewTasks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, viewId openapi_types.UUID, params scheme.ListViewTasksParams) {
+	ctx := r.Context()
+
+	page, err := s.viewsService.ListViewTasks(ctx, projectId.String(), viewId.String(), params)
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+	writePage(w, r, page, params.Envelope)
+}
+
+func (s *Server) ListLabels(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	ctx := r.Context()
+
+	labels, err := s.labelsService.ListLabels(ctx, projectId.String())
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, labels)
+}
+
+// CreateLabel leaves its Idempotency-Key to IdempotencyMiddleware.
+func (s *Server) CreateLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, _ scheme.CreateLabelParams) {
+	ctx := r.Context()
+
+	var body scheme.NewLabel
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
+		return
+	}
+
+	label, err := s.labelsService.CreateLabel(ctx, projectId.String(), body)
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusCreated, label)
+}
+
+func (s *Server) GetLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, labelId openapi_types.UUID) {
+	ctx := r.Context()
+
+	label, err := s.labelsService.GetLabel(ctx, projectId.String(), labelId.String())
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, label)
+}
+
+func (s *Server) UpdateLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, labelId openapi_types.UUID) {
+	ctx := r.Context()
+
+	var body scheme.UpdateLabel
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		helpers.WriteError(w, r, apierrors.ErrMalformedBody)
+		return
+	}
+
+	label, err := s.labelsService.UpdateLabel(ctx, projectId.String(), labelId.String(), body)
+	if err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	helpers.WriteJSON(w, http.StatusOK, label)
+}
+
+func (s *Server) DeleteLabel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, labelId openapi_types.UUID) {
+	ctx := r.Context()
+
+	if err := s.labelsService.DeleteLabel(ctx, projectId.String(), labelId.String()); err != nil {
+		helpers.WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ParamErrorHandler reports path and query parameters the generated wrappers
+// could not bind, using the same error format as the handlers.
+func ParamErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	var invalid *InvalidParamFormatError
+	if errors.As(err, &invalid) {
+		helpers.WriteError(w, r, apierrors.InvalidParameter(invalid.ParamName, "has an invalid format"))
+		return
+	}
+	var required *RequiredParamError
+	if errors.As(err, &required) {
+		helpers.WriteError(w, r, apierrors.InvalidParameter(required.ParamName, "is required"))
+		return
+	}
+	helpers.WriteError(w, r, apierrors.ErrInvalidParameter.WithMessage(err.Error()))
 }
@@ -3,28 +3,49 @@ package api_test
 import (
 	"bytes"
 	"context"
-	"database/sql"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	neturl "net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"full-stack-assesment/internal/api"
+	"full-stack-assesment/internal/config"
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/metrics"
+	"full-stack-assesment/internal/middleware"
 	"full-stack-assesment/internal/migrate"
+	idempotencyRepo "full-stack-assesment/internal/repo/idempotency"
+	labelsRepo "full-stack-assesment/internal/repo/labels"
 	projectsRepo "full-stack-assesment/internal/repo/projects"
 	tasksRepo "full-stack-assesment/internal/repo/task"
+	viewsRepo "full-stack-assesment/internal/repo/views"
+	"full-stack-assesment/internal/scheme"
+	batchService "full-stack-assesment/internal/service/batch"
+	healthService "full-stack-assesment/internal/service/health"
+	labelsService "full-stack-assesment/internal/service/labels"
 	projectsService "full-stack-assesment/internal/service/projects"
 	taskService "full-stack-assesment/internal/service/task"
+	viewsService "full-stack-assesment/internal/service/views"
+	"full-stack-assesment/internal/store"
+	"full-stack-assesment/internal/telemetry"
 
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-
-	_ "modernc.org/sqlite"
 )
 
+var linkRe = regexp.MustCompile(`<([^>]*)>; rel="([a-z]+)"`)
+
 var letterRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
 
 // init seeds the random number generator.
@@ -43,8 +64,10 @@ func RandStringRunes(n int) string {
 
 var _ = Describe("API Endpoints testing", Ordered, func() {
 	var (
-		db      *sql.DB
-		handler http.Handler
+		db              *store.DB
+		handler         http.Handler
+		hSvc            *healthService.HealthService
+		idempotencyKeys *idempotencyRepo.SQLiteIdempotencyRepo
 
 		seedProjectID    = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
 		invalidProjectID = "aaaaaaaa-aaaa-aaaa-aaaa-bbbbbbbbbbbb"
@@ -54,22 +77,49 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 	BeforeAll(func() {
 		var err error
 		ctx := context.Background()
-		db, err = sql.Open("sqlite", "file:todo?mode=memory&cache=shared&_fk=1")
+		db, err = store.InMemory(ctx)
 		Expect(err).NotTo(HaveOccurred())
-		db.SetMaxOpenConns(1)
-		db.SetMaxIdleConns(1)
 
-		Expect(migrate.Apply(ctx, db)).To(Succeed())
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
 
 		pRepo := projectsRepo.NewSQLiteProjectsRepo(db)
-		pSvc := projectsService.NewService(*pRepo)
+		limits := config.Default().Limits
+		pSvc := projectsService.NewService(*pRepo, limits)
 
 		tRepo := tasksRepo.NewSQLiteTaskRepo(db)
-		tSvc := taskService.NewService(*tRepo, *pSvc)
+		tSvc := taskService.NewService(*tRepo, *pSvc, limits, config.Tasks{EnforceBlockers: true})
+
+		vRepo := viewsRepo.NewSQLiteViewsRepo(db)
+		vSvc := viewsService.NewService(*vRepo, *pSvc, *tSvc, limits)
+
+		lRepo := labelsRepo.NewSQLiteLabelsRepo(db)
+		lSvc := labelsService.NewService(*lRepo, *pSvc)
 
-		s := api.NewServer(*pSvc, *tSvc)
+		hSvc = healthService.NewService(db)
+		hSvc.MarkReady()
+
+		bSvc := batchService.NewService(db, limits)
+		s := api.NewServer(*pSvc, *tSvc, *vSvc, *lSvc, hSvc, bSvc)
 		mux := http.NewServeMux()
-		handler = api.HandlerFromMux(s, mux)
+		h := api.HandlerWithOptions(s, api.StdHTTPServerOptions{
+			BaseRouter:       mux,
+			ErrorHandlerFunc: api.ParamErrorHandler,
+		})
+
+		// Check every response against the spec so handlers cannot drift
+		// from it unnoticed.
+		spec, err := api.GetSwagger()
+		Expect(err).NotTo(HaveOccurred())
+		validate, err := middleware.ValidationMiddleware(spec, middleware.ValidationOptions{
+			ValidateResponses: true,
+			OnResponseError: func(r *http.Request, err error) {
+				Fail(fmt.Sprintf("%s %s: response does not match the spec: %v", r.Method, r.URL, err))
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		idempotencyKeys = idempotencyRepo.NewSQLiteIdempotencyRepo(db)
+		handler = validate(middleware.IdempotencyMiddleware(idempotencyKeys, time.Hour)(h))
+		bSvc.Route(handler)
 	})
 
 	AfterAll(func() {
@@ -78,7 +128,8 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 		}
 	})
 
-	do := func(method, url string, body any) *httptest.ResponseRecorder {
+	// doWith sends a request carrying the extra headers in header.
+	doWith := func(method, url string, body any, header map[string]string) *httptest.ResponseRecorder {
 		var r io.Reader
 		if body != nil {
 			b, err := json.Marshal(body)
@@ -90,21 +141,70 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 		if body != nil {
 			req.Header.Set("Content-Type", "application/json")
 		}
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
 		rr := httptest.NewRecorder()
 		handler.ServeHTTP(rr, req)
 		return rr
 	}
 
+	do := func(method, url string, body any) *httptest.ResponseRecorder {
+		return doWith(method, url, body, nil)
+	}
+
 	readJSON := func(rr *httptest.ResponseRecorder, dest any) {
 		ExpectWithOffset(1, json.Unmarshal(rr.Body.Bytes(), dest)).To(Succeed(),
 			"status=%d body=%s", rr.Code, rr.Body.String())
 	}
 
+	// links parses the Link header into target by relation.
+	links := func(rr *httptest.ResponseRecorder) map[string]string {
+		out := map[string]string{}
+		for _, m := range linkRe.FindAllStringSubmatch(rr.Header().Get("Link"), -1) {
+			out[m[2]] = m[1]
+		}
+		return out
+	}
+
 	Describe("Health check endpoint", func() {
 		It("GET /health returns successful response", func() {
 			rr := do(http.MethodGet, "/health", nil)
 			Expect(rr.Code).To(Equal(http.StatusOK))
 		})
+
+		It("GET /health/live returns the Health schema", func() {
+			rr := do(http.MethodGet, "/health/live", nil)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			var health scheme.Health
+			readJSON(rr, &health)
+			Expect(health.Status).To(Equal(scheme.Ok))
+		})
+
+		It("GET /health/ready reports every component healthy", func() {
+			rr := do(http.MethodGet, "/health/ready", nil)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			var health scheme.Health
+			readJSON(rr, &health)
+			Expect(health.Status).To(Equal(scheme.Ok))
+			Expect(health.Components).NotTo(BeNil())
+			Expect(*health.Components).To(HaveKey("database"))
+			Expect(*health.Components).To(HaveKey("migrations"))
+			Expect((*health.Components)["migrations"].Status).To(Equal(scheme.Ok))
+		})
+
+		It("GET /health/ready is unavailable until startup finishes", func() {
+			gated := api.HandlerFromMux(api.NewServer(projectsService.ProjectsService{}, taskService.TaskService{}, viewsService.ViewsService{}, labelsService.LabelsService{}, healthService.NewService(db), nil), http.NewServeMux())
+			req := httptest.NewRequest(http.MethodGet, "/health/ready", nil)
+			rr := httptest.NewRecorder()
+			gated.ServeHTTP(rr, req)
+
+			Expect(rr.Code).To(Equal(http.StatusServiceUnavailable))
+			var health scheme.Health
+			readJSON(rr, &health)
+			Expect(health.Status).To(Equal(scheme.Unhealthy))
+			Expect((*health.Components)["startup"].Status).To(Equal(scheme.Unhealthy))
+		})
 	})
 
 	Describe("Projects endpoints", func() {
@@ -123,6 +223,31 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 			Expect(found).To(BeTrue(), "expected seeded Demo Project to exist")
 		})
 
+		It("GET /projects pages through every project once", func() {
+			Expect(do(http.MethodPost, "/projects", map[string]any{"name": "Paged A"}).Code).To(Equal(http.StatusCreated))
+			Expect(do(http.MethodPost, "/projects", map[string]any{"name": "Paged B"}).Code).To(Equal(http.StatusCreated))
+
+			rr := do(http.MethodGet, "/projects", nil)
+			total, err := strconv.Atoi(rr.Header().Get("X-Total-Count"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(BeNumerically(">=", 3))
+
+			seen := map[string]bool{}
+			next := "/projects?limit=1"
+			for next != "" {
+				rr := do(http.MethodGet, next, nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var page []map[string]any
+				readJSON(rr, &page)
+				Expect(page).To(HaveLen(1))
+				id := page[0]["id"].(string)
+				Expect(seen).NotTo(HaveKey(id))
+				seen[id] = true
+				next = links(rr)["next"]
+			}
+			Expect(seen).To(HaveLen(total))
+		})
+
 		It("POST /projects twice on a same name returns status conflict", func() {
 			body := map[string]any{"name": "Alpha"}
 			rr := do(http.MethodPost, "/projects", body)
@@ -136,9 +261,141 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 			Expect(rr2.Code).To(Equal(http.StatusConflict))
 		})
 
-		It("POST /projects rejects invalid body", func() {
+		It("POST /projects rejects a blank name", func() {
 			rr := do(http.MethodPost, "/projects", map[string]any{"name": "  "})
-			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+			Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+		})
+
+		Context("Get/Update/Delete", func() {
+			var projectID string
+
+			BeforeAll(func() {
+				rr := do(http.MethodPost, "/projects", map[string]any{"name": "Renamable"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				var created map[string]any
+				readJSON(rr, &created)
+				projectID = created["id"].(string)
+			})
+
+			It("GET /projects/{projectId} returns the project", func() {
+				rr := do(http.MethodGet, "/projects/"+projectID, nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var got map[string]any
+				readJSON(rr, &got)
+				Expect(got["id"]).To(Equal(projectID))
+				Expect(got["name"]).To(Equal("Renamable"))
+			})
+
+			It("GET /projects/{projectId} for a non existent project returns not found", func() {
+				rr := do(http.MethodGet, "/projects/"+invalidProjectID, nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+			})
+
+			It("PUT /projects/{projectId} renames the project", func() {
+				rr := do(http.MethodPut, "/projects/"+projectID, map[string]any{"name": "Renamed"})
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var got map[string]any
+				readJSON(rr, &got)
+				Expect(got["name"]).To(Equal("Renamed"))
+
+				rr = do(http.MethodGet, "/projects/"+projectID, nil)
+				readJSON(rr, &got)
+				Expect(got["name"]).To(Equal("Renamed"))
+			})
+
+			It("PUT /projects/{projectId} to an existing name returns status conflict", func() {
+				rr := do(http.MethodPut, "/projects/"+projectID, map[string]any{"name": seedProjectName})
+				Expect(rr.Code).To(Equal(http.StatusConflict))
+			})
+
+			It("PUT /projects/{projectId} with a blank name returns unprocessable entity", func() {
+				rr := do(http.MethodPut, "/projects/"+projectID, map[string]any{"name": "   "})
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+			})
+
+			It("PUT /projects/{projectId} for a non existent project returns not found", func() {
+				rr := do(http.MethodPut, "/projects/"+invalidProjectID, map[string]any{"name": "Ghost"})
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+			})
+
+			It("DELETE /projects/{projectId} removes the project and its tasks", func() {
+				rr := do(http.MethodPost, fmt.Sprintf("/projects/%s/tasks", projectID), map[string]any{"title": "Doomed"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+
+				rr = do(http.MethodDelete, "/projects/"+projectID, nil)
+				Expect(rr.Code).To(Equal(http.StatusNoContent))
+
+				rr = do(http.MethodGet, "/projects/"+projectID, nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+
+				var remaining int
+				Expect(db.QueryRow(`SELECT COUNT(*) FROM tasks WHERE project_id = ?`, projectID).Scan(&remaining)).To(Succeed())
+				Expect(remaining).To(BeZero())
+			})
+
+			It("DELETE /projects/{projectId} again returns not found", func() {
+				rr := do(http.MethodDelete, "/projects/"+projectID, nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("Conditional requests", func() {
+			var projectURL string
+
+			BeforeAll(func() {
+				rr := do(http.MethodPost, "/projects", map[string]any{"name": "Versioned"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"1"`))
+				var created scheme.Project
+				readJSON(rr, &created)
+				Expect(created.Version).To(Equal(1))
+				projectURL = "/projects/" + created.Id.String()
+			})
+
+			It("answers 304 when If-None-Match names the current ETag", func() {
+				rr := do(http.MethodGet, projectURL, nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				tag := rr.Header().Get("ETag")
+				Expect(tag).To(Equal(`"1"`))
+
+				rr = doWith(http.MethodGet, projectURL, nil, map[string]string{"If-None-Match": tag})
+				Expect(rr.Code).To(Equal(http.StatusNotModified))
+				Expect(rr.Header().Get("ETag")).To(Equal(tag))
+				Expect(rr.Body.Len()).To(BeZero())
+
+				rr = doWith(http.MethodGet, projectURL, nil, map[string]string{"If-None-Match": `"0", "7"`})
+				Expect(rr.Code).To(Equal(http.StatusOK))
+			})
+
+			It("renames only when If-Match names the current ETag", func() {
+				rr := doWith(http.MethodPut, projectURL, map[string]any{"name": "Versioned 2"}, map[string]string{"If-Match": `"1"`})
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"2"`))
+				var got scheme.Project
+				readJSON(rr, &got)
+				Expect(got.Version).To(Equal(2))
+
+				rr = doWith(http.MethodPut, projectURL, map[string]any{"name": "Clobbered"}, map[string]string{"If-Match": `"1"`})
+				Expect(rr.Code).To(Equal(http.StatusPreconditionFailed))
+				var apiErr scheme.Error
+				readJSON(rr, &apiErr)
+				Expect(apiErr.Code).To(Equal("PRECONDITION_FAILED"))
+
+				rr = do(http.MethodGet, projectURL, nil)
+				readJSON(rr, &got)
+				Expect(got.Name).To(Equal("Versioned 2"))
+			})
+
+			It("deletes only when If-Match names the current ETag", func() {
+				rr := doWith(http.MethodDelete, projectURL, nil, map[string]string{"If-Match": `"1"`})
+				Expect(rr.Code).To(Equal(http.StatusPreconditionFailed))
+
+				rr = doWith(http.MethodDelete, projectURL, nil, map[string]string{"If-Match": `"2"`})
+				Expect(rr.Code).To(Equal(http.StatusNoContent))
+
+				rr = doWith(http.MethodDelete, projectURL, nil, map[string]string{"If-Match": "*"})
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+			})
 		})
 	})
 
@@ -209,6 +466,488 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 				Expect(list).To(HaveLen(1))
 				Expect(list[0]["id"]).To(Equal(taskIDs[0]))
 			})
+
+			ids := func(rr *httptest.ResponseRecorder) []string {
+				var list []map[string]any
+				readJSON(rr, &list)
+				out := make([]string, 0, len(list))
+				for _, t := range list {
+					out = append(out, t["id"].(string))
+				}
+				return out
+			}
+
+			It("reports the total count and links to the next page by cursor", func() {
+				url := fmt.Sprintf("/projects/%s/tasks?limit=2", hostProjectID)
+				rr := do(http.MethodGet, url, nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("X-Total-Count")).To(Equal("3"))
+
+				rels := links(rr)
+				Expect(rels).To(HaveKeyWithValue("first", fmt.Sprintf("/projects/%s/tasks?limit=2", hostProjectID)))
+				Expect(rels).To(HaveKey("next"))
+				Expect(rels["next"]).To(ContainSubstring("after="))
+				Expect(rels["next"]).To(ContainSubstring("limit=2"))
+				Expect(rels).NotTo(HaveKey("prev"))
+			})
+
+			It("walks every task once by following the next links", func() {
+				next := fmt.Sprintf("/projects/%s/tasks?limit=1", hostProjectID)
+				var seen []string
+				for next != "" {
+					rr := do(http.MethodGet, next, nil)
+					Expect(rr.Code).To(Equal(http.StatusOK))
+					seen = append(seen, ids(rr)...)
+					next = links(rr)["next"]
+				}
+				Expect(seen).To(Equal([]string{taskIDs[2], taskIDs[1], taskIDs[0]}))
+			})
+
+			It("goes back a page with the prev link", func() {
+				rr := do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks?limit=1", hostProjectID), nil)
+				rr = do(http.MethodGet, links(rr)["next"], nil)
+				Expect(ids(rr)).To(Equal([]string{taskIDs[1]}))
+
+				prev := links(rr)["prev"]
+				Expect(prev).To(ContainSubstring("before="))
+				rr = do(http.MethodGet, prev, nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(ids(rr)).To(Equal([]string{taskIDs[2]}))
+				Expect(links(rr)).NotTo(HaveKey("prev"))
+				Expect(links(rr)).To(HaveKey("next"))
+			})
+
+			It("keeps offset paging on offset links", func() {
+				rr := do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks?limit=1&offset=1", hostProjectID), nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				rels := links(rr)
+				Expect(rels["prev"]).To(Equal(fmt.Sprintf("/projects/%s/tasks?limit=1", hostProjectID)))
+				Expect(rels["next"]).To(Equal(fmt.Sprintf("/projects/%s/tasks?limit=1&offset=2", hostProjectID)))
+			})
+
+			It("wraps the page in an envelope on request", func() {
+				rr := do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks?limit=2&envelope=true", hostProjectID), nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var page scheme.TaskPage
+				readJSON(rr, &page)
+				Expect(page.Items).To(HaveLen(2))
+				Expect(page.Page.Limit).To(Equal(2))
+				Expect(page.Page.Total).To(Equal(3))
+				Expect(page.Page.PrevCursor).To(BeNil())
+				Expect(page.Page.NextCursor).NotTo(BeNil())
+
+				rr = do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks?limit=2&envelope=true&after=%s", hostProjectID, *page.Page.NextCursor), nil)
+				var last scheme.TaskPage
+				readJSON(rr, &last)
+				Expect(last.Items).To(HaveLen(1))
+				Expect(last.Items[0].Id.String()).To(Equal(taskIDs[0]))
+				Expect(last.Page.NextCursor).To(BeNil())
+				Expect(last.Page.PrevCursor).NotTo(BeNil())
+			})
+
+			It("neither repeats nor skips unchanged tasks when one is updated between pages", func() {
+				rr := do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks?limit=1", hostProjectID), nil)
+				Expect(ids(rr)).To(Equal([]string{taskIDs[2]}))
+				next := links(rr)["next"]
+
+				// Moves taskIDs[1] ahead of the cursor; an offset of 1 would now
+				// return taskIDs[2] a second time.
+				upd := do(http.MethodPut, fmt.Sprintf("/projects/%s/tasks/%s", hostProjectID, taskIDs[1]), map[string]any{"status": "IN_PROGRESS"})
+				Expect(upd.Code).To(Equal(http.StatusOK))
+
+				rr = do(http.MethodGet, next, nil)
+				Expect(ids(rr)).To(Equal([]string{taskIDs[0]}))
+			})
+
+			It("rejects cursors it did not issue", func() {
+				rr := do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks?after=bm90LWEtY3Vyc29y", hostProjectID), nil)
+				Expect(rr.Code).To(Equal(http.StatusBadRequest))
+				var got scheme.Error
+				readJSON(rr, &got)
+				Expect(got.Code).To(Equal("INVALID_PARAMETER"))
+				Expect(*got.Details).To(ContainElement(HaveField("Field", "after")))
+			})
+
+			It("rejects a cursor combined with an offset", func() {
+				rr := do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks?limit=1", hostProjectID), nil)
+				rr = do(http.MethodGet, links(rr)["next"]+"&offset=1", nil)
+				Expect(rr.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("Filtering and sorting", func() {
+			var (
+				listURL string
+				created = map[string]scheme.Task{}
+			)
+
+			titles := func(rr *httptest.ResponseRecorder) []string {
+				ExpectWithOffset(1, rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+				var list []scheme.Task
+				readJSON(rr, &list)
+				out := make([]string, 0, len(list))
+				for _, t := range list {
+					out = append(out, t.Title)
+				}
+				return out
+			}
+
+			BeforeAll(func() {
+				rr := do(http.MethodPost, "/projects", map[string]any{"name": "Sorting host"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				var project scheme.Project
+				readJSON(rr, &project)
+				listURL = fmt.Sprintf("/projects/%s/tasks", project.Id)
+
+				for _, t := range []map[string]any{
+					{"title": "Bravo", "status": "TODO", "description": "1000 items to migrate"},
+					{"title": "Alpha", "status": "DONE", "description": "reach 100% coverage"},
+					{"title": "Charlie", "status": "IN_PROGRESS"},
+				} {
+					rr := do(http.MethodPost, listURL, t)
+					Expect(rr.Code).To(Equal(http.StatusCreated))
+					var task scheme.Task
+					readJSON(rr, &task)
+					created[task.Title] = task
+					time.Sleep(5 * time.Millisecond)
+				}
+			})
+
+			It("sorts by the requested keys and directions", func() {
+				Expect(titles(do(http.MethodGet, listURL+"?sort=title", nil))).To(Equal([]string{"Alpha", "Bravo", "Charlie"}))
+				Expect(titles(do(http.MethodGet, listURL+"?sort=-title", nil))).To(Equal([]string{"Charlie", "Bravo", "Alpha"}))
+				Expect(titles(do(http.MethodGet, listURL+"?sort=status,-createdAt", nil))).To(Equal([]string{"Alpha", "Charlie", "Bravo"}))
+				Expect(titles(do(http.MethodGet, listURL+"?sort=createdAt", nil))).To(Equal([]string{"Bravo", "Alpha", "Charlie"}))
+			})
+
+			It("pages through a custom sort with cursors", func() {
+				var seen []string
+				next := listURL + "?sort=-title&limit=1"
+				for next != "" {
+					rr := do(http.MethodGet, next, nil)
+					seen = append(seen, titles(rr)...)
+					next = links(rr)["next"]
+				}
+				Expect(seen).To(Equal([]string{"Charlie", "Bravo", "Alpha"}))
+			})
+
+			It("matches any of several statuses", func() {
+				Expect(titles(do(http.MethodGet, listURL+"?status=TODO&status=DONE&sort=title", nil))).To(Equal([]string{"Alpha", "Bravo"}))
+				Expect(titles(do(http.MethodGet, listURL+"?status=IN_PROGRESS", nil))).To(Equal([]string{"Charlie"}))
+			})
+
+			It("searches descriptions with LIKE wildcards taken literally", func() {
+				Expect(titles(do(http.MethodGet, listURL+"?description=COVERAGE", nil))).To(Equal([]string{"Alpha"}))
+				Expect(titles(do(http.MethodGet, listURL+"?description="+neturl.QueryEscape("100%"), nil))).To(Equal([]string{"Alpha"}))
+				Expect(titles(do(http.MethodGet, listURL+"?description=_", nil))).To(BeEmpty())
+			})
+
+			It("filters by creation and update time ranges", func() {
+				from := created["Alpha"].CreatedAt.Format(time.RFC3339Nano)
+				to := created["Charlie"].CreatedAt.Format(time.RFC3339Nano)
+
+				Expect(titles(do(http.MethodGet, listURL+"?sort=createdAt&createdAfter="+neturl.QueryEscape(from), nil))).
+					To(Equal([]string{"Alpha", "Charlie"}))
+				Expect(titles(do(http.MethodGet, listURL+"?sort=createdAt&createdAfter="+neturl.QueryEscape(from)+"&createdBefore="+neturl.QueryEscape(to), nil))).
+					To(Equal([]string{"Alpha"}))
+				Expect(titles(do(http.MethodGet, listURL+"?updatedBefore="+neturl.QueryEscape(from), nil))).
+					To(Equal([]string{"Bravo"}))
+			})
+
+			It("applies a filter expression", func() {
+				filter := func(expr string) string { return "&filter=" + neturl.QueryEscape(expr) }
+
+				Expect(titles(do(http.MethodGet, listURL+"?sort=title"+filter(`status in (TODO, DONE) and not description ~ "coverage"`), nil))).
+					To(Equal([]string{"Bravo"}))
+				Expect(titles(do(http.MethodGet, listURL+"?sort=title"+filter(`title = Charlie or (status = done and updatedAt > -1h)`), nil))).
+					To(Equal([]string{"Alpha", "Charlie"}))
+				Expect(titles(do(http.MethodGet, listURL+"?sort=title"+filter(`createdAt < -1h`), nil))).To(BeEmpty())
+				Expect(titles(do(http.MethodGet, listURL+"?sort=title&status=TODO"+filter(`title in (Alpha, Bravo)`), nil))).
+					To(Equal([]string{"Bravo"}))
+				Expect(titles(do(http.MethodGet, listURL+"?sort=title"+filter(`title = "x' OR 1=1 --"`), nil))).To(BeEmpty())
+			})
+
+			It("reports filter syntax errors with their column", func() {
+				rr := do(http.MethodGet, listURL+"?filter="+neturl.QueryEscape("status = DONE and priority > 1"), nil)
+				Expect(rr.Code).To(Equal(http.StatusBadRequest))
+				var got scheme.Error
+				readJSON(rr, &got)
+				Expect(got.Code).To(Equal("INVALID_FILTER"))
+				Expect(got.Message).To(ContainSubstring(`unknown field "priority"`))
+				Expect(*got.Details).To(ContainElement(And(
+					HaveField("Field", "filter"),
+					HaveField("Message", ContainSubstring("at column 19")),
+				)))
+			})
+
+			It("rejects an empty time range", func() {
+				from := created["Alpha"].CreatedAt.Format(time.RFC3339Nano)
+				rr := do(http.MethodGet, listURL+"?updatedAfter="+neturl.QueryEscape(from)+"&updatedBefore="+neturl.QueryEscape(from), nil)
+				Expect(rr.Code).To(Equal(http.StatusBadRequest))
+				var got scheme.Error
+				readJSON(rr, &got)
+				Expect(*got.Details).To(ContainElement(HaveField("Field", "updatedBefore")))
+			})
+
+			It("rejects sort keys outside the whitelist", func() {
+				for _, sort := range []string{"priority", "title;DROP TABLE tasks", "title,title", "title,-title"} {
+					rr := do(http.MethodGet, listURL+"?sort="+neturl.QueryEscape(sort), nil)
+					Expect(rr.Code).To(Equal(http.StatusBadRequest), sort)
+					var got scheme.Error
+					readJSON(rr, &got)
+					Expect(got.Code).To(Equal("INVALID_PARAMETER"))
+					Expect(*got.Details).To(ContainElement(HaveField("Field", "sort")))
+				}
+			})
+
+			It("rejects a cursor issued for another sort", func() {
+				rr := do(http.MethodGet, listURL+"?limit=1", nil)
+				next := links(rr)["next"]
+				rr = do(http.MethodGet, next+"&sort=title", nil)
+				Expect(rr.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("Across projects", func() {
+			var projectA, projectB string
+
+			BeforeAll(func() {
+				newProject := func(name string, titles ...string) string {
+					rr := do(http.MethodPost, "/projects", map[string]any{"name": name})
+					Expect(rr.Code).To(Equal(http.StatusCreated))
+					var project scheme.Project
+					readJSON(rr, &project)
+					for _, title := range titles {
+						rr := do(http.MethodPost, fmt.Sprintf("/projects/%s/tasks", project.Id), map[string]any{"title": title, "status": "TODO"})
+						Expect(rr.Code).To(Equal(http.StatusCreated))
+					}
+					return project.Id.String()
+				}
+				projectA = newProject("Dashboard A", "Dash 1", "Dash 3")
+				projectB = newProject("Dashboard B", "Dash 2")
+			})
+
+			list := func(url string) []scheme.ProjectTask {
+				rr := do(http.MethodGet, url, nil)
+				ExpectWithOffset(1, rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+				var tasks []scheme.ProjectTask
+				readJSON(rr, &tasks)
+				return tasks
+			}
+			titles := func(tasks []scheme.ProjectTask) []string {
+				out := make([]string, 0, len(tasks))
+				for _, t := range tasks {
+					out = append(out, t.Title)
+				}
+				return out
+			}
+			both := func() string { return "/tasks?projectId=" + projectA + "&projectId=" + projectB }
+
+			It("lists the tasks of several projects in one request", func() {
+				tasks := list(both() + "&sort=title")
+				Expect(titles(tasks)).To(Equal([]string{"Dash 1", "Dash 2", "Dash 3"}))
+				Expect(tasks[0].ProjectName).To(BeNil())
+				Expect(tasks[1].ProjectId.String()).To(Equal(projectB))
+			})
+
+			It("applies a filter expression across projects", func() {
+				Expect(titles(list(both() + "&sort=title&filter=" + neturl.QueryEscape(`title in ("Dash 1", "Dash 2")`)))).
+					To(Equal([]string{"Dash 1", "Dash 2"}))
+				Expect(do(http.MethodGet, "/tasks?filter="+neturl.QueryEscape("title ~"), nil).Code).To(Equal(http.StatusBadRequest))
+			})
+
+			It("embeds project names on request", func() {
+				tasks := list(both() + "&sort=title&embed=project")
+				names := make([]string, 0, len(tasks))
+				for _, t := range tasks {
+					Expect(t.ProjectName).NotTo(BeNil())
+					names = append(names, *t.ProjectName)
+				}
+				Expect(names).To(Equal([]string{"Dashboard A", "Dashboard B", "Dashboard A"}))
+			})
+
+			It("applies the listing filters and pages with cursors", func() {
+				Expect(titles(list(both() + "&q=" + neturl.QueryEscape("dash 2")))).To(Equal([]string{"Dash 2"}))
+				Expect(list(both() + "&status=DONE")).To(BeEmpty())
+
+				var seen []string
+				next := both() + "&sort=-title&limit=1&embed=project"
+				for next != "" {
+					rr := do(http.MethodGet, next, nil)
+					Expect(rr.Code).To(Equal(http.StatusOK))
+					Expect(rr.Header().Get("X-Total-Count")).To(Equal("3"))
+					var tasks []scheme.ProjectTask
+					readJSON(rr, &tasks)
+					seen = append(seen, titles(tasks)...)
+					next = links(rr)["next"]
+				}
+				Expect(seen).To(Equal([]string{"Dash 3", "Dash 2", "Dash 1"}))
+			})
+
+			It("wraps the page in an envelope on request", func() {
+				rr := do(http.MethodGet, both()+"&limit=2&envelope=true", nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var page scheme.ProjectTaskPage
+				readJSON(rr, &page)
+				Expect(page.Items).To(HaveLen(2))
+				Expect(page.Page.Total).To(Equal(3))
+				Expect(page.Page.NextCursor).NotTo(BeNil())
+			})
+
+			It("covers every project without a projectId", func() {
+				rr := do(http.MethodGet, "/projects?limit=200", nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var projects []scheme.Project
+				readJSON(rr, &projects)
+
+				sum := 0
+				for _, p := range projects {
+					rr := do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks?limit=1", p.Id), nil)
+					Expect(rr.Code).To(Equal(http.StatusOK))
+					n, err := strconv.Atoi(rr.Header().Get("X-Total-Count"))
+					Expect(err).NotTo(HaveOccurred())
+					sum += n
+				}
+
+				rr = do(http.MethodGet, "/tasks?limit=1", nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("X-Total-Count")).To(Equal(strconv.Itoa(sum)))
+			})
+
+			It("returns nothing for unknown projects", func() {
+				Expect(list("/tasks?projectId=" + invalidProjectID)).To(BeEmpty())
+			})
+
+			It("rejects invalid filters", func() {
+				Expect(do(http.MethodGet, "/tasks?sort=priority", nil).Code).To(Equal(http.StatusBadRequest))
+				Expect(do(http.MethodGet, "/tasks?embed=owner", nil).Code).To(Equal(http.StatusBadRequest))
+				Expect(do(http.MethodGet, "/tasks?projectId=nope", nil).Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("Search", func() {
+			var (
+				projectA, projectB string
+				created            = map[string]scheme.Task{}
+			)
+
+			search := func(query string) []scheme.TaskSearchHit {
+				rr := do(http.MethodGet, "/tasks/search?"+query, nil)
+				ExpectWithOffset(1, rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+				var hits []scheme.TaskSearchHit
+				readJSON(rr, &hits)
+				ExpectWithOffset(1, rr.Header().Get("X-Total-Count")).NotTo(BeEmpty())
+				return hits
+			}
+			titles := func(hits []scheme.TaskSearchHit) []string {
+				out := make([]string, 0, len(hits))
+				for _, h := range hits {
+					out = append(out, h.Task.Title)
+				}
+				return out
+			}
+			q := func(s string) string { return "q=" + neturl.QueryEscape(s) }
+
+			BeforeAll(func() {
+				newProject := func(name string) string {
+					rr := do(http.MethodPost, "/projects", map[string]any{"name": name})
+					Expect(rr.Code).To(Equal(http.StatusCreated))
+					var project scheme.Project
+					readJSON(rr, &project)
+					return project.Id.String()
+				}
+				projectA, projectB = newProject("Search host A"), newProject("Search host B")
+
+				for _, t := range []struct {
+					project string
+					body    map[string]any
+				}{
+					{projectA, map[string]any{"title": "Quokka migration plan", "description": "Move the wombat cluster"}},
+					{projectA, map[string]any{"title": "Wombat onboarding", "description": "Explain the quokka review process"}},
+					{projectA, map[string]any{"title": "Release notes for wombats", "description": "Draft the <script>alert(1)</script> notes"}},
+					{projectB, map[string]any{"title": "Quokka dashboards", "description": "Numbat metrics and review notes"}},
+				} {
+					rr := do(http.MethodPost, "/projects/"+t.project+"/tasks", t.body)
+					Expect(rr.Code).To(Equal(http.StatusCreated))
+					var task scheme.Task
+					readJSON(rr, &task)
+					created[task.Title] = task
+				}
+			})
+
+			It("searches every project and ranks title matches first", func() {
+				hits := search(q("wombat"))
+				Expect(titles(hits)).To(Equal([]string{"Wombat onboarding", "Quokka migration plan"}))
+				Expect(hits[0].Score).To(BeNumerically(">", hits[1].Score))
+
+				Expect(titles(search(q("quokka")))).To(ConsistOf("Quokka migration plan", "Quokka dashboards", "Wombat onboarding"))
+			})
+
+			It("limits the search to one project", func() {
+				Expect(titles(search(q("quokka") + "&projectId=" + projectB))).To(Equal([]string{"Quokka dashboards"}))
+			})
+
+			It("matches prefixes, phrases, alternatives and exclusions", func() {
+				Expect(titles(search(q("wombat*")))).To(ConsistOf("Wombat onboarding", "Quokka migration plan", "Release notes for wombats"))
+				Expect(titles(search(q(`"review process"`)))).To(Equal([]string{"Wombat onboarding"}))
+				Expect(titles(search(q("review")))).To(ConsistOf("Wombat onboarding", "Quokka dashboards"))
+				Expect(titles(search(q("numbat OR onboarding")))).To(ConsistOf("Wombat onboarding", "Quokka dashboards"))
+				Expect(titles(search(q("quokka -review")))).To(Equal([]string{"Quokka migration plan"}))
+			})
+
+			It("takes FTS5 syntax in the query literally", func() {
+				Expect(titles(search(q("title:wombat")))).To(BeEmpty())
+				Expect(titles(search(q(`quokka AND NEAR(" (`)))).To(BeEmpty())
+				Expect(titles(search(q("migration)")))).To(Equal([]string{"Quokka migration plan"}))
+			})
+
+			It("marks the matches in escaped titles and snippets", func() {
+				hits := search(q("notes"))
+				Expect(titles(hits)).To(Equal([]string{"Release notes for wombats", "Quokka dashboards"}))
+				Expect(hits[0].Title).To(Equal("Release <mark>notes</mark> for wombats"))
+				Expect(*hits[0].Snippet).To(Equal("Draft the &lt;script&gt;alert(1)&lt;/script&gt; <mark>notes</mark>"))
+				Expect(*hits[1].Snippet).To(Equal("Numbat metrics and review <mark>notes</mark>"))
+			})
+
+			It("pages through the hits", func() {
+				rr := do(http.MethodGet, "/tasks/search?"+q("wombat*")+"&limit=2", nil)
+				Expect(rr.Header().Get("X-Total-Count")).To(Equal("3"))
+				var first []scheme.TaskSearchHit
+				readJSON(rr, &first)
+				Expect(first).To(HaveLen(2))
+
+				rest := search(q("wombat*") + "&limit=2&offset=2")
+				Expect(rest).To(HaveLen(1))
+				Expect(titles(first)).NotTo(ContainElement(rest[0].Task.Title))
+			})
+
+			It("follows task updates and deletes", func() {
+				task := created["Quokka dashboards"]
+				taskURL := fmt.Sprintf("/projects/%s/tasks/%s", task.ProjectId, task.Id)
+
+				rr := do(http.MethodPut, taskURL, map[string]any{"title": "Platypus dashboards"})
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(titles(search(q("platypus")))).To(Equal([]string{"Platypus dashboards"}))
+				Expect(titles(search(q("quokka")))).NotTo(ContainElement("Quokka dashboards"))
+
+				Expect(do(http.MethodDelete, taskURL, nil).Code).To(Equal(http.StatusNoContent))
+				Expect(search(q("platypus"))).To(BeEmpty())
+			})
+
+			It("rejects a query without words", func() {
+				for _, query := range []string{q(`"" * -`), q("-quokka"), ""} {
+					rr := do(http.MethodGet, "/tasks/search?"+query, nil)
+					Expect(rr.Code).To(Equal(http.StatusBadRequest), query)
+					var got scheme.Error
+					readJSON(rr, &got)
+					Expect(*got.Details).To(ContainElement(HaveField("Field", "q")))
+				}
+			})
+
+			It("returns 404 for an unknown project", func() {
+				rr := do(http.MethodGet, "/tasks/search?"+q("quokka")+"&projectId="+invalidProjectID, nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+			})
 		})
 
 		Context("Create", func() {
@@ -246,19 +985,19 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 				Expect(rr.Code).To(Equal(http.StatusBadRequest))
 			})
 
-			It("POST with invalid status returns bad request", func() {
+			It("POST with invalid status returns unprocessable entity", func() {
 				url := fmt.Sprintf("/projects/%s/tasks", hostProjectID)
 				rr := do(http.MethodPost, url, map[string]any{
 					"title":  "Bad status",
 					"status": "WHATEVER",
 				})
-				Expect(rr.Code).To(Equal(http.StatusBadRequest))
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
 			})
 
-			It("POST with blank title returns bad request", func() {
+			It("POST with blank title returns unprocessable entity", func() {
 				url := fmt.Sprintf("/projects/%s/tasks", hostProjectID)
 				rr := do(http.MethodPost, url, map[string]any{"title": "   "})
-				Expect(rr.Code).To(Equal(http.StatusBadRequest))
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
 			})
 		})
 
@@ -299,11 +1038,11 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 				Expect(task["projectId"]).To(Equal(hostProjectID))
 			})
 
-			It("GET wrong project ownership returns bad request", func() {
+			It("GET wrong project ownership returns not found", func() {
 				url := fmt.Sprintf("/projects/%s/tasks/%s", seedProjectID, t1ID)
 				rr := do(http.MethodGet, url, nil)
-				Expect(rr.Code).To(Equal(http.StatusBadRequest))
-				Expect(rr.Body.String()).To(ContainSubstring("invalid task id"))
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+				Expect(rr.Body.String()).To(ContainSubstring("task not found"))
 			})
 
 			It("GET /.../tasks?status=INVALID returns bad request", func() {
@@ -311,6 +1050,9 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 				rr := do(http.MethodGet, url, nil)
 				Expect(rr.Code).To(Equal(http.StatusBadRequest))
 				Expect(rr.Body.String()).To(ContainSubstring("invalid status"))
+
+				rr = do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks?status=", hostProjectID), nil)
+				Expect(rr.Code).To(Equal(http.StatusBadRequest))
 			})
 
 			It("GET /.../tasks?q=... filters by title", func() {
@@ -373,13 +1115,13 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 				Expect(task["status"]).To(Equal("IN_PROGRESS"))
 			})
 
-			It("PUT with long title returns bad request", func() {
+			It("PUT with long title returns unprocessable entity", func() {
 				url := fmt.Sprintf("/projects/%s/tasks/%s", hostProjectID, t2ID)
 				rr := do(http.MethodPut, url, map[string]any{
 					"title":  RandStringRunes(205),
 					"status": "IN_PROGRESS",
 				})
-				Expect(rr.Code).To(Equal(http.StatusBadRequest))
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
 			})
 
 			It("PUT with no body returns current task", func() {
@@ -397,30 +1139,196 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 
 			It("PUT task with invalid project returns not found", func() {
 				url := fmt.Sprintf("/projects/%s/tasks/%s", invalidProjectID, t2ID)
-				rr := do(http.MethodPut, url, nil)
+				rr := do(http.MethodPut, url, map[string]any{"status": "DONE"})
 				Expect(rr.Code).To(Equal(http.StatusNotFound))
 				Expect(rr.Body.String()).To(ContainSubstring("not found"))
 			})
 
-			It("PUT invalid status returns bad request", func() {
+			It("PUT invalid status returns unprocessable entity", func() {
 				url := fmt.Sprintf("/projects/%s/tasks/%s", hostProjectID, t2ID)
 				rr := do(http.MethodPut, url, map[string]any{"status": "BAD"})
-				Expect(rr.Code).To(Equal(http.StatusBadRequest))
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
 			})
 
 			It("PUT against missing task returns not found", func() {
 				url := fmt.Sprintf("/projects/%s/tasks/%s", hostProjectID, "ffffffff-ffff-ffff-ffff-ffffffffffff")
 				rr := do(http.MethodPut, url, map[string]any{"title": "x"})
-				Expect([]int{http.StatusNotFound, http.StatusBadRequest}).To(ContainElement(rr.Code))
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
 			})
 		})
 
-		Context("Delete", func() {
-			var t3ID string
+		Context("Update (PATCH)", func() {
+			var taskURL string
+
+			// patch sends body as contentType with the extra headers in header.
+			patch := func(contentType, body string, header map[string]string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodPatch, taskURL, strings.NewReader(body))
+				req.Header.Set("Content-Type", contentType)
+				for k, v := range header {
+					req.Header.Set(k, v)
+				}
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, req)
+				return rr
+			}
+			mergePatch := func(body string) *httptest.ResponseRecorder {
+				return patch("application/merge-patch+json", body, nil)
+			}
+			jsonPatch := func(body string) *httptest.ResponseRecorder {
+				return patch("application/json-patch+json", body, nil)
+			}
+			current := func() scheme.Task {
+				var task scheme.Task
+				readJSON(do(http.MethodGet, taskURL, nil), &task)
+				return task
+			}
+			errorCode := func(rr *httptest.ResponseRecorder) scheme.Error {
+				var apiErr scheme.Error
+				readJSON(rr, &apiErr)
+				return apiErr
+			}
 
 			BeforeAll(func() {
-				url := fmt.Sprintf("/projects/%s/tasks", hostProjectID)
-				rr := do(http.MethodPost, url, map[string]any{"title": "T3"})
+				rr := do(http.MethodPost, fmt.Sprintf("/projects/%s/tasks", hostProjectID), map[string]any{
+					"title":       "Patched",
+					"description": "Before",
+				})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				var task scheme.Task
+				readJSON(rr, &task)
+				taskURL = fmt.Sprintf("/projects/%s/tasks/%s", hostProjectID, task.Id)
+			})
+
+			It("merges a merge patch, clearing the description with null", func() {
+				rr := mergePatch(`{"status": "IN_PROGRESS", "description": null}`)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"2"`))
+				var task scheme.Task
+				readJSON(rr, &task)
+				Expect(task.Title).To(Equal("Patched"))
+				Expect(task.Status).To(Equal(scheme.TaskStatus("IN_PROGRESS")))
+				Expect(task.Description).To(BeNil())
+				Expect(current()).To(Equal(task))
+			})
+
+			It("writes nothing for a patch that changes nothing", func() {
+				rr := mergePatch(`{"title": "Patched"}`)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"2"`))
+			})
+
+			It("applies JSON Patch operations in order after their tests pass", func() {
+				rr := jsonPatch(`[
+					{"op": "test", "path": "/status", "value": "IN_PROGRESS"},
+					{"op": "test", "path": "/version", "value": 2},
+					{"op": "add", "path": "/description", "value": "Added"},
+					{"op": "copy", "from": "/description", "path": "/title"},
+					{"op": "replace", "path": "/status", "value": "DONE"}
+				]`)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var task scheme.Task
+				readJSON(rr, &task)
+				Expect(task.Title).To(Equal("Added"))
+				Expect(*task.Description).To(Equal("Added"))
+				Expect(task.Status).To(Equal(scheme.TaskStatus("DONE")))
+				Expect(task.Version).To(Equal(3))
+			})
+
+			It("applies nothing when a test operation fails", func() {
+				before := current()
+				rr := jsonPatch(`[
+					{"op": "replace", "path": "/title", "value": "Lost"},
+					{"op": "test", "path": "/status", "value": "TODO"}
+				]`)
+				Expect(rr.Code).To(Equal(http.StatusConflict))
+				apiErr := errorCode(rr)
+				Expect(apiErr.Code).To(Equal("PATCH_TEST_FAILED"))
+				Expect(*apiErr.Details).To(ConsistOf(scheme.ErrorDetail{Field: "/status", Message: "does not hold the tested value"}))
+				Expect(current()).To(Equal(before))
+			})
+
+			It("refuses to change read-only or unknown members", func() {
+				rr := jsonPatch(`[{"op": "replace", "path": "/version", "value": 99}]`)
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(*errorCode(rr).Details).To(ConsistOf(scheme.ErrorDetail{Field: "version", Message: "is read-only"}))
+
+				rr = mergePatch(`{"id": null}`)
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(*errorCode(rr).Details).To(ConsistOf(scheme.ErrorDetail{Field: "id", Message: "is read-only"}))
+
+				rr = mergePatch(`{"owner": "someone"}`)
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(*errorCode(rr).Details).To(ConsistOf(scheme.ErrorDetail{Field: "owner", Message: "is not a task field"}))
+			})
+
+			It("checks the patched task like a create", func() {
+				rr := jsonPatch(`[{"op": "remove", "path": "/title"}]`)
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(errorCode(rr).Code).To(Equal("VALIDATION_FAILED"))
+
+				rr = jsonPatch(`[{"op": "replace", "path": "/status", "value": "LATER"}]`)
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(*errorCode(rr).Details).To(ContainElement(HaveField("Field", "status")))
+
+				rr = jsonPatch(`[{"op": "replace", "path": "/description", "value": 7}]`)
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(*errorCode(rr).Details).To(ContainElement(HaveField("Field", "description")))
+			})
+
+			It("rejects operations that cannot apply", func() {
+				rr := jsonPatch(`[{"op": "remove", "path": "/missing"}]`)
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				apiErr := errorCode(rr)
+				Expect(apiErr.Code).To(Equal("INVALID_PATCH"))
+				Expect(*apiErr.Details).To(ConsistOf(scheme.ErrorDetail{Field: "/missing", Message: "path does not exist"}))
+
+				rr = jsonPatch(`[{"op": "move", "from": "/title", "path": "/title/x"}]`)
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(errorCode(rr).Code).To(Equal("INVALID_PATCH"))
+			})
+
+			It("rejects malformed documents and other media types", func() {
+				Expect(mergePatch(`{"title":`).Code).To(Equal(http.StatusBadRequest))
+				Expect(jsonPatch(`{"op": "remove", "path": "/title"}`).Code).To(Equal(http.StatusBadRequest))
+
+				rr := patch("application/json", `{"title": "x"}`, nil)
+				Expect(rr.Code).To(Equal(http.StatusUnsupportedMediaType))
+				Expect(errorCode(rr).Message).To(Equal("request body must be application/json-patch+json or application/merge-patch+json"))
+			})
+
+			It("honours If-Match", func() {
+				version := current().Version
+				rr := patch("application/merge-patch+json", `{"title": "Stale"}`, map[string]string{"If-Match": `"1"`})
+				Expect(rr.Code).To(Equal(http.StatusPreconditionFailed))
+
+				rr = patch("application/merge-patch+json", `{"title": "Fresh"}`, map[string]string{"If-Match": fmt.Sprintf(`"%d"`, version)})
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("ETag")).To(Equal(fmt.Sprintf(`"%d"`, version+1)))
+			})
+
+			It("returns 404 for unknown tasks and projects", func() {
+				req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/projects/%s/tasks/%s", hostProjectID, invalidProjectID), strings.NewReader(`{}`))
+				req.Header.Set("Content-Type", "application/merge-patch+json")
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, req)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+				Expect(errorCode(rr).Code).To(Equal("TASK_NOT_FOUND"))
+
+				req = httptest.NewRequest(http.MethodPatch, strings.Replace(taskURL, hostProjectID, invalidProjectID, 1), strings.NewReader(`{}`))
+				req.Header.Set("Content-Type", "application/merge-patch+json")
+				rr = httptest.NewRecorder()
+				handler.ServeHTTP(rr, req)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+				Expect(errorCode(rr).Code).To(Equal("PROJECT_NOT_FOUND"))
+			})
+		})
+
+		Context("Delete", func() {
+			var t3ID string
+
+			BeforeAll(func() {
+				url := fmt.Sprintf("/projects/%s/tasks", hostProjectID)
+				rr := do(http.MethodPost, url, map[string]any{"title": "T3"})
 				Expect(rr.Code).To(Equal(http.StatusCreated))
 				var task map[string]any
 				readJSON(rr, &task)
@@ -440,13 +1348,1572 @@ var _ = Describe("API Endpoints testing", Ordered, func() {
 				Expect(rr.Code).To(Equal(http.StatusNoContent))
 			})
 
-			It("DELETE again the same task returns invalid task and bad request", func() {
+			It("DELETE again the same task returns task not found", func() {
 				url := fmt.Sprintf("/projects/%s/tasks/%s", hostProjectID, t3ID)
 				rr := do(http.MethodDelete, url, nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+				Expect(rr.Body.String()).To(ContainSubstring("task not found"))
+			})
+
+		})
+
+		Context("Conditional requests", func() {
+			var taskURL string
+
+			ifMatch := func(tag string) map[string]string { return map[string]string{"If-Match": tag} }
+
+			BeforeAll(func() {
+				rr := do(http.MethodPost, fmt.Sprintf("/projects/%s/tasks", hostProjectID), map[string]any{"title": "Contested"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"1"`))
+				var created scheme.Task
+				readJSON(rr, &created)
+				Expect(created.Version).To(Equal(1))
+				taskURL = fmt.Sprintf("/projects/%s/tasks/%s", hostProjectID, created.Id)
+			})
+
+			It("answers 304 when If-None-Match names the current ETag", func() {
+				for _, header := range []string{`"1"`, `W/"1"`, `"9", "1"`, "*"} {
+					rr := doWith(http.MethodGet, taskURL, nil, map[string]string{"If-None-Match": header})
+					Expect(rr.Code).To(Equal(http.StatusNotModified), header)
+					Expect(rr.Header().Get("ETag")).To(Equal(`"1"`))
+					Expect(rr.Body.Len()).To(BeZero())
+				}
+
+				rr := doWith(http.MethodGet, taskURL, nil, map[string]string{"If-None-Match": `"2"`})
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"1"`))
+			})
+
+			It("bumps the version on every change", func() {
+				rr := doWith(http.MethodPut, taskURL, map[string]any{"status": "IN_PROGRESS"}, ifMatch(`"1"`))
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"2"`))
+
+				rr = do(http.MethodPut, taskURL, map[string]any{"description": "no precondition"})
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var got scheme.Task
+				readJSON(rr, &got)
+				Expect(got.Version).To(Equal(3))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"3"`))
+
+				rr = doWith(http.MethodPut, taskURL, map[string]any{}, ifMatch(`"3"`))
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"3"`))
+			})
+
+			It("refuses to overwrite a newer version", func() {
+				for _, tag := range []string{`"2"`, `W/"3"`, `"4"`} {
+					rr := doWith(http.MethodPut, taskURL, map[string]any{"title": "Clobbered"}, ifMatch(tag))
+					Expect(rr.Code).To(Equal(http.StatusPreconditionFailed), tag)
+					var apiErr scheme.Error
+					readJSON(rr, &apiErr)
+					Expect(apiErr.Code).To(Equal("PRECONDITION_FAILED"))
+				}
+				Expect(doWith(http.MethodPut, taskURL, map[string]any{}, ifMatch(`"2"`)).Code).To(Equal(http.StatusPreconditionFailed))
+
+				rr := do(http.MethodGet, taskURL, nil)
+				var got scheme.Task
+				readJSON(rr, &got)
+				Expect(got.Title).To(Equal("Contested"))
+				Expect(got.Version).To(Equal(3))
+
+				rr = doWith(http.MethodPut, taskURL, map[string]any{"title": "Won"}, ifMatch("*"))
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"4"`))
+			})
+
+			It("deletes only the current version", func() {
+				Expect(doWith(http.MethodDelete, taskURL, nil, ifMatch(`"3"`)).Code).To(Equal(http.StatusPreconditionFailed))
+				Expect(doWith(http.MethodDelete, taskURL, nil, ifMatch(`"4"`)).Code).To(Equal(http.StatusNoContent))
+				Expect(doWith(http.MethodDelete, taskURL, nil, ifMatch(`"4"`)).Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("Bulk", func() {
+			var (
+				bulkURL, tasksURL string
+				keepID, dropID    string
+			)
+
+			bulk := func(body any) (*httptest.ResponseRecorder, scheme.BulkTaskResponse) {
+				rr := do(http.MethodPost, bulkURL, body)
+				var res scheme.BulkTaskResponse
+				if rr.Code == http.StatusOK || rr.Code == http.StatusMultiStatus || rr.Code == http.StatusUnprocessableEntity {
+					_ = json.Unmarshal(rr.Body.Bytes(), &res)
+				}
+				return rr, res
+			}
+			titles := func() []string {
+				var tasks []scheme.Task
+				readJSON(do(http.MethodGet, tasksURL+"?sort=title", nil), &tasks)
+				var out []string
+				for _, t := range tasks {
+					out = append(out, t.Title+":"+string(t.Status))
+				}
+				return out
+			}
+			errorCode := func(rr *httptest.ResponseRecorder) scheme.Error {
+				var apiErr scheme.Error
+				readJSON(rr, &apiErr)
+				return apiErr
+			}
+
+			BeforeAll(func() {
+				rr := do(http.MethodPost, "/projects", map[string]any{"name": "BulkHost"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				var project scheme.Project
+				readJSON(rr, &project)
+				bulkURL = fmt.Sprintf("/projects/%s/tasks:bulk", project.Id)
+				tasksURL = fmt.Sprintf("/projects/%s/tasks", project.Id)
+
+				for _, title := range []string{"Keep", "Drop"} {
+					rr := do(http.MethodPost, tasksURL, map[string]any{"title": title})
+					Expect(rr.Code).To(Equal(http.StatusCreated))
+					var task scheme.Task
+					readJSON(rr, &task)
+					if title == "Keep" {
+						keepID = task.Id.String()
+					} else {
+						dropID = task.Id.String()
+					}
+				}
+			})
+
+			It("applies every operation in order and reports each task", func() {
+				rr, res := bulk(map[string]any{"operations": []map[string]any{
+					{"op": "create", "task": map[string]any{"title": "Fresh"}},
+					{"op": "setStatus", "ids": []string{keepID}, "status": "IN_PROGRESS"},
+					{"op": "update", "ids": []string{keepID}, "changes": map[string]any{"description": "kept"}},
+					{"op": "delete", "ids": []string{dropID}},
+				}})
+				Expect(rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+				Expect(res.Mode).To(Equal(scheme.BulkModeAtomic))
+				Expect(res.Committed).To(BeTrue())
+				Expect(res.Succeeded).To(Equal(4))
+				Expect(res.Failed).To(BeZero())
+
+				var statuses []int
+				for i, r := range res.Results {
+					Expect(r.Index).To(Equal(i))
+					Expect(r.Error).To(BeNil())
+					statuses = append(statuses, r.Status)
+				}
+				Expect(statuses).To(Equal([]int{201, 200, 200, 204}))
+				Expect(res.Results[0].Task.Title).To(Equal("Fresh"))
+				Expect(*res.Results[0].Id).To(Equal(res.Results[0].Task.Id))
+				Expect(res.Results[2].Task.Status).To(Equal(scheme.TaskStatus("IN_PROGRESS")))
+				Expect(*res.Results[2].Task.Description).To(Equal("kept"))
+				Expect(res.Results[3].Task).To(BeNil())
+
+				Expect(titles()).To(Equal([]string{"Fresh:TODO", "Keep:IN_PROGRESS"}))
+			})
+
+			It("rolls an atomic request back at the first failing task", func() {
+				rr, res := bulk(map[string]any{"operations": []map[string]any{
+					{"op": "create", "task": map[string]any{"title": "Doomed"}},
+					{"op": "delete", "ids": []string{keepID, dropID, keepID}},
+					{"op": "setStatus", "filter": "status = TODO", "status": "DONE"},
+				}})
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity), rr.Body.String())
+				Expect(res.Committed).To(BeFalse())
+				Expect(res.Succeeded).To(BeZero())
+				Expect(res.Failed).To(Equal(5))
+
+				var statuses []int
+				for _, r := range res.Results {
+					Expect(r.Task).To(BeNil())
+					statuses = append(statuses, r.Status)
+				}
+				Expect(statuses).To(Equal([]int{424, 424, 404, 424, 424}))
+				Expect(res.Results[0].Id).To(BeNil())
+				Expect(res.Results[0].Error.Code).To(Equal("BULK_ABORTED"))
+				Expect(res.Results[2].Error.Code).To(Equal("TASK_NOT_FOUND"))
+				Expect(res.Results[2].Id.String()).To(Equal(dropID))
+				Expect(res.Results[4].Index).To(Equal(2))
+
+				Expect(titles()).To(Equal([]string{"Fresh:TODO", "Keep:IN_PROGRESS"}))
+			})
+
+			It("keeps the tasks that succeed in bestEffort mode", func() {
+				rr, res := bulk(map[string]any{"mode": "bestEffort", "operations": []map[string]any{
+					{"op": "create", "task": map[string]any{"title": "Survivor"}},
+					{"op": "create", "task": map[string]any{"title": "   "}},
+					{"op": "delete", "ids": []string{dropID}},
+				}})
+				Expect(rr.Code).To(Equal(http.StatusMultiStatus), rr.Body.String())
+				Expect(res.Committed).To(BeTrue())
+				Expect(res.Succeeded).To(Equal(1))
+				Expect(res.Failed).To(Equal(2))
+				Expect(res.Results[0].Status).To(Equal(http.StatusCreated))
+				Expect(res.Results[1].Error.Code).To(Equal("VALIDATION_FAILED"))
+				Expect(res.Results[2].Status).To(Equal(http.StatusNotFound))
+
+				Expect(titles()).To(Equal([]string{"Fresh:TODO", "Keep:IN_PROGRESS", "Survivor:TODO"}))
+			})
+
+			It("resolves filters when their operation runs", func() {
+				rr, res := bulk(map[string]any{"operations": []map[string]any{
+					{"op": "setStatus", "filter": `title = "Fresh"`, "status": "IN_PROGRESS"},
+					{"op": "setStatus", "filter": "status = IN_PROGRESS", "status": "DONE"},
+				}})
+				Expect(rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+				Expect(res.Succeeded).To(Equal(3))
+				Expect(res.Results[0].Id.String()).NotTo(Equal(keepID))
+				Expect(titles()).To(Equal([]string{"Fresh:DONE", "Keep:DONE", "Survivor:TODO"}))
+			})
+
+			It("rejects malformed operations before running any", func() {
+				for _, tc := range []struct {
+					op    map[string]any
+					field string
+				}{
+					{map[string]any{"op": "create"}, "operations.1.task"},
+					{map[string]any{"op": "create", "task": map[string]any{"title": "x"}, "ids": []string{keepID}}, "operations.1.ids"},
+					{map[string]any{"op": "update", "ids": []string{keepID}}, "operations.1.changes"},
+					{map[string]any{"op": "setStatus", "ids": []string{keepID}}, "operations.1.status"},
+					{map[string]any{"op": "delete"}, "operations.1.ids"},
+					{map[string]any{"op": "delete", "ids": []string{keepID}, "filter": "status = DONE"}, "operations.1.ids"},
+					{map[string]any{"op": "delete", "filter": "nope ="}, "operations.1.filter"},
+				} {
+					rr, _ := bulk(map[string]any{"operations": []map[string]any{
+						{"op": "delete", "ids": []string{keepID}},
+						tc.op,
+					}})
+					Expect(rr.Code).To(Or(Equal(http.StatusBadRequest), Equal(http.StatusUnprocessableEntity)), tc.field)
+					apiErr := errorCode(rr)
+					Expect(apiErr.Details).NotTo(BeNil(), tc.field)
+					Expect((*apiErr.Details)[0].Field).To(Equal(tc.field))
+				}
+				Expect(titles()).To(ContainElement("Keep:DONE"))
+			})
+
+			It("refuses requests touching too many tasks", func() {
+				ids := make([]string, config.Default().Limits.BulkMaxItems+1)
+				for i := range ids {
+					ids[i] = fmt.Sprintf("00000000-0000-4000-8000-%012d", i)
+				}
+				rr, _ := bulk(map[string]any{"operations": []map[string]any{
+					{"op": "setStatus", "filter": "status = DONE", "status": "TODO"},
+					{"op": "delete", "ids": ids},
+				}})
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(errorCode(rr).Code).To(Equal("VALIDATION_FAILED"))
+				Expect(titles()).To(ContainElement("Keep:DONE"))
+			})
+
+			It("returns not found for an unknown project", func() {
+				rr := do(http.MethodPost, fmt.Sprintf("/projects/%s/tasks:bulk", invalidProjectID), map[string]any{
+					"operations": []map[string]any{{"op": "create", "task": map[string]any{"title": "Lost"}}},
+				})
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+				Expect(errorCode(rr).Code).To(Equal("PROJECT_NOT_FOUND"))
+			})
+
+			It("deletes subtasks matched along with their parent once", func() {
+				rr := do(http.MethodPost, tasksURL, map[string]any{"title": "Nest", "status": "DONE"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				var parent scheme.Task
+				readJSON(rr, &parent)
+				rr = do(http.MethodPost, tasksURL, map[string]any{"title": "Chick", "status": "DONE", "parentId": parent.Id})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+
+				rr, res := bulk(map[string]any{"operations": []map[string]any{
+					{"op": "delete", "filter": "status = DONE"},
+				}})
+				Expect(rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+				Expect(res.Committed).To(BeTrue())
+				Expect(res.Failed).To(Equal(0))
+				for _, r := range res.Results {
+					Expect(r.Status).To(Equal(http.StatusNoContent))
+				}
+				Expect(titles()).To(Equal([]string{"Survivor:TODO"}))
+			})
+		})
+
+		Context("Subtasks", func() {
+			var projectID, tasksURL string
+
+			create := func(title string, extra map[string]any) scheme.Task {
+				body := map[string]any{"title": title}
+				for k, v := range extra {
+					body[k] = v
+				}
+				rr := do(http.MethodPost, tasksURL, body)
+				ExpectWithOffset(1, rr.Code).To(Equal(http.StatusCreated), rr.Body.String())
+				var task scheme.Task
+				readJSON(rr, &task)
+				return task
+			}
+			get := func(id fmt.Stringer) (*httptest.ResponseRecorder, scheme.Task) {
+				rr := do(http.MethodGet, fmt.Sprintf("%s/%s", tasksURL, id), nil)
+				var task scheme.Task
+				if rr.Code == http.StatusOK {
+					readJSON(rr, &task)
+				}
+				return rr, task
+			}
+			errorCode := func(rr *httptest.ResponseRecorder) scheme.Error {
+				var apiErr scheme.Error
+				readJSON(rr, &apiErr)
+				return apiErr
+			}
+
+			BeforeAll(func() {
+				rr := do(http.MethodPost, "/projects", map[string]any{"name": "SubtasksHost"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				var project scheme.Project
+				readJSON(rr, &project)
+				projectID = project.Id.String()
+				tasksURL = fmt.Sprintf("/projects/%s/tasks", project.Id)
+			})
+
+			It("creates a subtask and rolls its status up into the parent's progress", func() {
+				parent := create("Parent", nil)
+				Expect(parent.ParentId).To(BeNil())
+				Expect(parent.Progress).To(BeNil())
+
+				child := create("Child", map[string]any{"parentId": parent.Id, "status": "DONE"})
+				Expect(child.ParentId).To(HaveValue(Equal(parent.Id)))
+				grandchild := create("Grandchild", map[string]any{"parentId": child.Id})
+
+				rr, got := get(parent.Id)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(got.Progress).To(HaveValue(Equal(scheme.TaskProgress{Done: 1, Total: 2})))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"3"`))
+
+				taskURL := fmt.Sprintf("%s/%s", tasksURL, grandchild.Id)
+				Expect(do(http.MethodPut, taskURL, map[string]any{"title": "Renamed grandchild"}).Code).To(Equal(http.StatusOK))
+				_, got = get(parent.Id)
+				Expect(got.Version).To(Equal(3))
+
+				Expect(do(http.MethodPut, taskURL, map[string]any{"status": "DONE"}).Code).To(Equal(http.StatusOK))
+				rr = doWith(http.MethodGet, fmt.Sprintf("%s/%s", tasksURL, parent.Id), nil, map[string]string{"If-None-Match": `"3"`})
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				_, got = get(parent.Id)
+				Expect(got.Progress).To(HaveValue(Equal(scheme.TaskProgress{Done: 2, Total: 2})))
+				Expect(got.Version).To(Equal(4))
+				_, got = get(child.Id)
+				Expect(got.Version).To(Equal(3))
+
+				Expect(do(http.MethodDelete, taskURL, nil).Code).To(Equal(http.StatusNoContent))
+				_, got = get(parent.Id)
+				Expect(got.Progress).To(HaveValue(Equal(scheme.TaskProgress{Done: 1, Total: 1})))
+				Expect(got.Version).To(Equal(5))
+			})
+
+			It("rejects a parent from another project or that does not exist", func() {
+				rr := do(http.MethodPost, "/projects", map[string]any{"name": "SubtasksOther"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				var other scheme.Project
+				readJSON(rr, &other)
+				rr = do(http.MethodPost, fmt.Sprintf("/projects/%s/tasks", other.Id), map[string]any{"title": "Elsewhere"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				var foreign scheme.Task
+				readJSON(rr, &foreign)
+
+				for _, parentID := range []string{foreign.Id.String(), invalidProjectID} {
+					rr := do(http.MethodPost, tasksURL, map[string]any{"title": "Orphan", "parentId": parentID})
+					Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+					apiErr := errorCode(rr)
+					Expect(apiErr.Code).To(Equal("VALIDATION_FAILED"))
+					Expect(apiErr.Details).To(HaveValue(ContainElement(HaveField("Field", "parentId"))))
+				}
+			})
+
+			It("refuses to make a task its own ancestor", func() {
+				a := create("A", nil)
+				b := create("B", map[string]any{"parentId": a.Id})
+				c := create("C", map[string]any{"parentId": b.Id})
+				_, before := get(a.Id)
+
+				rr := do(http.MethodPut, fmt.Sprintf("%s/%s", tasksURL, a.Id), map[string]any{"title": "A", "parentId": c.Id.String()})
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(errorCode(rr).Code).To(Equal("VALIDATION_FAILED"))
+
+				req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/%s", tasksURL, b.Id),
+					strings.NewReader(fmt.Sprintf(`{"parentId": %q}`, b.Id)))
+				req.Header.Set("Content-Type", "application/merge-patch+json")
+				rr = httptest.NewRecorder()
+				handler.ServeHTTP(rr, req)
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(errorCode(rr).Code).To(Equal("VALIDATION_FAILED"))
+
+				_, got := get(a.Id)
+				Expect(got.ParentId).To(BeNil())
+				Expect(got.Version).To(Equal(before.Version))
+			})
+
+			It("moves a task to the top level with a null or empty parent", func() {
+				root := create("Root", nil)
+				first := create("First", map[string]any{"parentId": root.Id})
+				second := create("Second", map[string]any{"parentId": root.Id})
+
+				req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("%s/%s", tasksURL, first.Id),
+					strings.NewReader(`{"parentId": null}`))
+				req.Header.Set("Content-Type", "application/merge-patch+json")
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, req)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var patched scheme.Task
+				readJSON(rr, &patched)
+				Expect(patched.ParentId).To(BeNil())
+				Expect(patched.Version).To(Equal(2))
+
+				rr = do(http.MethodPut, fmt.Sprintf("%s/%s", tasksURL, second.Id), map[string]any{"title": "Second", "parentId": ""})
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var put scheme.Task
+				readJSON(rr, &put)
+				Expect(put.ParentId).To(BeNil())
+
+				_, got := get(root.Id)
+				Expect(got.Progress).To(BeNil())
+			})
+
+			It("lists the direct children of a task", func() {
+				parent := create("Lister", nil)
+				create("Kid 1", map[string]any{"parentId": parent.Id})
+				kid := create("Kid 2", map[string]any{"parentId": parent.Id, "status": "DONE"})
+				create("Nested", map[string]any{"parentId": kid.Id})
+
+				rr := do(http.MethodGet, fmt.Sprintf("%s/%s/children?sort=title", tasksURL, parent.Id), nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var children []scheme.Task
+				readJSON(rr, &children)
+				Expect(children).To(HaveLen(2))
+				Expect(children[0].Title).To(Equal("Kid 1"))
+				Expect(children[1].Title).To(Equal("Kid 2"))
+				Expect(children[1].Progress).To(HaveValue(Equal(scheme.TaskProgress{Done: 0, Total: 1})))
+
+				rr = do(http.MethodGet, fmt.Sprintf("%s/%s/children?status=DONE", tasksURL, parent.Id), nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				readJSON(rr, &children)
+				Expect(children).To(HaveLen(1))
+				Expect(children[0].Title).To(Equal("Kid 2"))
+
+				rr = do(http.MethodGet, fmt.Sprintf("%s/%s/children", tasksURL, invalidProjectID), nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+			})
+
+			It("lists only top-level tasks when asked", func() {
+				var tasks []scheme.Task
+				readJSON(do(http.MethodGet, tasksURL+"?topLevel=true&limit=100", nil), &tasks)
+				Expect(tasks).NotTo(BeEmpty())
+				for _, t := range tasks {
+					Expect(t.ParentId).To(BeNil(), t.Title)
+				}
+				Expect(tasks).To(ContainElement(HaveField("Title", "Lister")))
+				Expect(tasks).NotTo(ContainElement(HaveField("Title", "Kid 1")))
+
+				var across []scheme.ProjectTask
+				readJSON(do(http.MethodGet, "/tasks?topLevel=true&limit=100&projectId="+projectID, nil), &across)
+				Expect(across).To(HaveLen(len(tasks)))
+				Expect(across).To(ContainElement(HaveField("Title", "Lister")))
+				Expect(across).NotTo(ContainElement(HaveField("Title", "Kid 1")))
+			})
+
+			It("returns the whole tree of a task", func() {
+				root := create("Tree", nil)
+				left := create("Left", map[string]any{"parentId": root.Id, "status": "DONE"})
+				create("Right", map[string]any{"parentId": root.Id})
+				create("Leaf", map[string]any{"parentId": left.Id, "status": "DONE"})
+
+				rr := do(http.MethodGet, fmt.Sprintf("%s/%s/tree", tasksURL, root.Id), nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				var tree scheme.TaskTree
+				readJSON(rr, &tree)
+				Expect(tree.Task.Id).To(Equal(root.Id))
+				Expect(tree.Task.Progress).To(HaveValue(Equal(scheme.TaskProgress{Done: 2, Total: 3})))
+				Expect(tree.Children).To(HaveLen(2))
+				Expect(tree.Children[0].Task.Title).To(Equal("Left"))
+				Expect(tree.Children[0].Task.Progress).To(HaveValue(Equal(scheme.TaskProgress{Done: 1, Total: 1})))
+				Expect(tree.Children[0].Children).To(HaveLen(1))
+				Expect(tree.Children[0].Children[0].Task.Title).To(Equal("Leaf"))
+				Expect(tree.Children[1].Task.Title).To(Equal("Right"))
+				Expect(tree.Children[1].Children).To(BeEmpty())
+
+				rr = do(http.MethodGet, fmt.Sprintf("%s/%s/tree", tasksURL, invalidProjectID), nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+			})
+
+			It("deletes the subtasks with their parent by default", func() {
+				parent := create("Doomed", nil)
+				child := create("Doomed child", map[string]any{"parentId": parent.Id})
+				grandchild := create("Doomed grandchild", map[string]any{"parentId": child.Id})
+
+				rr := do(http.MethodDelete, fmt.Sprintf("%s/%s", tasksURL, parent.Id), nil)
+				Expect(rr.Code).To(Equal(http.StatusNoContent))
+				for _, id := range []fmt.Stringer{child.Id, grandchild.Id} {
+					rr, _ := get(id)
+					Expect(rr.Code).To(Equal(http.StatusNotFound))
+				}
+			})
+
+			It("promotes the subtasks to the grandparent when asked", func() {
+				top := create("Top", nil)
+				middle := create("Middle", map[string]any{"parentId": top.Id})
+				bottom := create("Bottom", map[string]any{"parentId": middle.Id})
+				nested := create("Nested bottom", map[string]any{"parentId": bottom.Id})
+
+				rr := do(http.MethodDelete, fmt.Sprintf("%s/%s?subtasks=promote", tasksURL, middle.Id), nil)
+				Expect(rr.Code).To(Equal(http.StatusNoContent))
+
+				rr, got := get(bottom.Id)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(got.ParentId).To(HaveValue(Equal(top.Id)))
+				Expect(got.Version).To(Equal(3))
+				_, got = get(nested.Id)
+				Expect(got.ParentId).To(HaveValue(Equal(bottom.Id)))
+				Expect(got.Version).To(Equal(1))
+
+				rr = do(http.MethodDelete, fmt.Sprintf("%s/%s?subtasks=promote", tasksURL, top.Id), nil)
+				Expect(rr.Code).To(Equal(http.StatusNoContent))
+				_, got = get(bottom.Id)
+				Expect(got.ParentId).To(BeNil())
+
+				rr = do(http.MethodDelete, fmt.Sprintf("%s/%s?subtasks=orphan", tasksURL, bottom.Id), nil)
 				Expect(rr.Code).To(Equal(http.StatusBadRequest))
-				Expect(rr.Body.String()).To(ContainSubstring("invalid task"))
 			})
+		})
+
+		Context("Dependencies", func() {
+			var tasksURL, otherTasksURL string
+
+			create := func(url, title string) scheme.Task {
+				rr := do(http.MethodPost, url, map[string]any{"title": title})
+				ExpectWithOffset(1, rr.Code).To(Equal(http.StatusCreated), rr.Body.String())
+				var task scheme.Task
+				readJSON(rr, &task)
+				return task
+			}
+			blockerURL := func(task, blocker scheme.Task) string {
+				return fmt.Sprintf("/projects/%s/tasks/%s/blockers/%s", task.ProjectId, task.Id, blocker.Id)
+			}
+			get := func(task scheme.Task) scheme.Task {
+				var got scheme.Task
+				readJSON(do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks/%s", task.ProjectId, task.Id), nil), &got)
+				return got
+			}
+			setStatus := func(task scheme.Task, status string) *httptest.ResponseRecorder {
+				return do(http.MethodPut, fmt.Sprintf("/projects/%s/tasks/%s", task.ProjectId, task.Id), map[string]any{"status": status})
+			}
+			errorCode := func(rr *httptest.ResponseRecorder) scheme.Error {
+				var apiErr scheme.Error
+				readJSON(rr, &apiErr)
+				return apiErr
+			}
+
+			BeforeAll(func() {
+				for i, name := range []string{"DependenciesHost", "DependenciesOther"} {
+					rr := do(http.MethodPost, "/projects", map[string]any{"name": name})
+					Expect(rr.Code).To(Equal(http.StatusCreated))
+					var project scheme.Project
+					readJSON(rr, &project)
+					if i == 0 {
+						tasksURL = fmt.Sprintf("/projects/%s/tasks", project.Id)
+					} else {
+						otherTasksURL = fmt.Sprintf("/projects/%s/tasks", project.Id)
+					}
+				}
+			})
+
+			It("records blockers across projects on both tasks", func() {
+				design := create(tasksURL, "Design")
+				build := create(tasksURL, "Build")
+				contract := create(otherTasksURL, "Contract")
+
+				rr := do(http.MethodPut, blockerURL(build, design), nil)
+				Expect(rr.Code).To(Equal(http.StatusOK))
+				Expect(rr.Header().Get("ETag")).To(Equal(`"2"`))
+				var got scheme.Task
+				readJSON(rr, &got)
+				Expect(got.BlockedBy).To(HaveValue(Equal([]openapi_types.UUID{design.Id})))
+				Expect(got.Version).To(Equal(2))
+				Expect(get(design).Version).To(Equal(2))
+				rr = doWith(http.MethodGet, fmt.Sprintf("%s/%s", tasksURL, design.Id), nil, map[string]string{"If-None-Match": `"1"`})
+				Expect(rr.Code).To(Equal(http.StatusOK))
+
+				Expect(do(http.MethodPut, blockerURL(build, contract), nil).Code).To(Equal(http.StatusOK))
+				Expect(do(http.MethodPut, blockerURL(build, design), nil).Code).To(Equal(http.StatusOK))
+				Expect(get(build).Version).To(Equal(3))
+
+				Expect(get(build).BlockedBy).To(HaveValue(Equal([]openapi_types.UUID{design.Id, contract.Id})))
+				Expect(get(build).Blocks).To(BeNil())
+				Expect(get(design).Blocks).To(HaveValue(Equal([]openapi_types.UUID{build.Id})))
+				Expect(get(contract).Blocks).To(HaveValue(Equal([]openapi_types.UUID{build.Id})))
+				Expect(get(contract).BlockedBy).To(BeNil())
+
+				var listed []scheme.Task
+				readJSON(do(http.MethodGet, tasksURL+"?q=Build", nil), &listed)
+				Expect(listed).To(HaveLen(1))
+				Expect(listed[0].BlockedBy).To(HaveValue(HaveLen(2)))
+			})
+
+			It("refuses dependencies that would be circular", func() {
+				a := create(tasksURL, "Cycle A")
+				b := create(tasksURL, "Cycle B")
+				c := create(otherTasksURL, "Cycle C")
+				Expect(do(http.MethodPut, blockerURL(b, a), nil).Code).To(Equal(http.StatusOK))
+				Expect(do(http.MethodPut, blockerURL(c, b), nil).Code).To(Equal(http.StatusOK))
+
+				for _, rr := range []*httptest.ResponseRecorder{
+					do(http.MethodPut, blockerURL(a, c), nil),
+					do(http.MethodPut, blockerURL(a, b), nil),
+					do(http.MethodPut, blockerURL(a, a), nil),
+				} {
+					Expect(rr.Code).To(Equal(http.StatusConflict))
+					Expect(errorCode(rr).Code).To(Equal("DEPENDENCY_CYCLE"))
+				}
+				Expect(get(a).BlockedBy).To(BeNil())
+			})
+
+			It("removes a dependency", func() {
+				first := create(tasksURL, "Remove first")
+				second := create(tasksURL, "Remove second")
+				Expect(do(http.MethodPut, blockerURL(second, first), nil).Code).To(Equal(http.StatusOK))
+
+				Expect(do(http.MethodDelete, blockerURL(second, first), nil).Code).To(Equal(http.StatusNoContent))
+				Expect(get(second).BlockedBy).To(BeNil())
+				Expect(get(first).Blocks).To(BeNil())
+				Expect(get(second).Version).To(Equal(3))
+				Expect(get(first).Version).To(Equal(3))
+
+				rr := do(http.MethodDelete, blockerURL(second, first), nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+				Expect(errorCode(rr).Code).To(Equal("DEPENDENCY_NOT_FOUND"))
+			})
+
+			It("returns not found for an unknown task or blocker", func() {
+				task := create(tasksURL, "Lonely")
+				ghost := scheme.Task{Id: helpers.MustUUID(invalidProjectID), ProjectId: task.ProjectId}
+
+				rr := do(http.MethodPut, blockerURL(task, ghost), nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+				Expect(errorCode(rr).Code).To(Equal("TASK_NOT_FOUND"))
+
+				rr = do(http.MethodPut, blockerURL(ghost, task), nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+				Expect(errorCode(rr).Code).To(Equal("TASK_NOT_FOUND"))
+			})
+
+			It("refuses to start or finish a task until its blockers are done", func() {
+				blocker := create(otherTasksURL, "Blocker")
+				blocked := create(tasksURL, "Blocked")
+				Expect(do(http.MethodPut, blockerURL(blocked, blocker), nil).Code).To(Equal(http.StatusOK))
+
+				for _, status := range []string{"IN_PROGRESS", "DONE"} {
+					rr := setStatus(blocked, status)
+					Expect(rr.Code).To(Equal(http.StatusConflict))
+					apiErr := errorCode(rr)
+					Expect(apiErr.Code).To(Equal("TASK_BLOCKED"))
+					Expect(apiErr.Details).To(HaveValue(ContainElement(HaveField("Message", ContainSubstring(blocker.Id.String())))))
+				}
+				Expect(setStatus(blocked, "TODO").Code).To(Equal(http.StatusOK))
+				Expect(do(http.MethodPut, fmt.Sprintf("%s/%s", tasksURL, blocked.Id), map[string]any{"title": "Still blocked"}).Code).To(Equal(http.StatusOK))
+
+				Expect(setStatus(blocker, "DONE").Code).To(Equal(http.StatusOK))
+				Expect(setStatus(blocked, "IN_PROGRESS").Code).To(Equal(http.StatusOK))
+			})
+
+			It("drops the dependencies of a deleted task", func() {
+				gone := create(tasksURL, "Gone")
+				stays := create(tasksURL, "Stays")
+				Expect(do(http.MethodPut, blockerURL(stays, gone), nil).Code).To(Equal(http.StatusOK))
+
+				Expect(do(http.MethodDelete, fmt.Sprintf("%s/%s", tasksURL, gone.Id), nil).Code).To(Equal(http.StatusNoContent))
+				Expect(get(stays).BlockedBy).To(BeNil())
+				Expect(get(stays).Version).To(Equal(3))
+				Expect(setStatus(stays, "DONE").Code).To(Equal(http.StatusOK))
+			})
+
+			It("drops the dependencies of a deleted project's tasks in other projects", func() {
+				rr := do(http.MethodPost, "/projects", map[string]any{"name": "DependenciesDoomed"})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				var doomed scheme.Project
+				readJSON(rr, &doomed)
+				doomedURL := fmt.Sprintf("/projects/%s/tasks", doomed.Id)
+
+				inside := create(doomedURL, "Inside")
+				blocked := create(tasksURL, "Blocked from outside")
+				blocking := create(tasksURL, "Blocking from outside")
+				Expect(do(http.MethodPut, blockerURL(blocked, inside), nil).Code).To(Equal(http.StatusOK))
+				Expect(do(http.MethodPut, blockerURL(inside, blocking), nil).Code).To(Equal(http.StatusOK))
+
+				Expect(do(http.MethodDelete, "/projects/"+doomed.Id.String(), nil).Code).To(Equal(http.StatusNoContent))
+				Expect(get(blocked).BlockedBy).To(BeNil())
+				Expect(get(blocked).Version).To(Equal(3))
+				Expect(get(blocking).Blocks).To(BeNil())
+				Expect(get(blocking).Version).To(Equal(3))
+			})
+		})
+	})
+
+	Describe("Views", func() {
+		var (
+			projectURL string
+			viewsURL   string
+		)
+
+		BeforeAll(func() {
+			rr := do(http.MethodPost, "/projects", map[string]any{"name": "Views host"})
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			var project scheme.Project
+			readJSON(rr, &project)
+			projectURL = "/projects/" + project.Id.String()
+			viewsURL = projectURL + "/views"
+
+			for _, t := range []map[string]any{
+				{"title": "Write docs", "status": "TODO"},
+				{"title": "Deploy api", "status": "IN_PROGRESS"},
+				{"title": "Deploy web", "status": "TODO"},
+				{"title": "Deploy db", "status": "DONE"},
+			} {
+				Expect(do(http.MethodPost, projectURL+"/tasks", t).Code).To(Equal(http.StatusCreated))
+			}
+		})
+
+		createView := func(body map[string]any) scheme.View {
+			rr := do(http.MethodPost, viewsURL, body)
+			ExpectWithOffset(1, rr.Code).To(Equal(http.StatusCreated), rr.Body.String())
+			var view scheme.View
+			readJSON(rr, &view)
+			return view
+		}
+		titles := func(rr *httptest.ResponseRecorder) []string {
+			ExpectWithOffset(1, rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+			var list []scheme.Task
+			readJSON(rr, &list)
+			out := make([]string, 0, len(list))
+			for _, t := range list {
+				out = append(out, t.Title)
+			}
+			return out
+		}
+
+		It("saves a view and runs it like a task listing", func() {
+			view := createView(map[string]any{
+				"name":    "Open deploys",
+				"filter":  `status in (TODO, IN_PROGRESS) and title ~ deploy`,
+				"sort":    "title",
+				"columns": []string{"title", "status"},
+			})
+			Expect(view.Name).To(Equal("Open deploys"))
+			Expect(*view.Filter).To(Equal(`status in (TODO, IN_PROGRESS) and title ~ deploy`))
+			Expect(*view.Sort).To(Equal("title"))
+			Expect(view.Columns).To(Equal([]scheme.ViewColumn{scheme.ViewColumnTitle, scheme.ViewColumnStatus}))
+
+			runURL := fmt.Sprintf("%s/%s/tasks", viewsURL, view.Id)
+			Expect(titles(do(http.MethodGet, runURL, nil))).To(Equal([]string{"Deploy api", "Deploy web"}))
+
+			rr := do(http.MethodGet, runURL+"?limit=1", nil)
+			Expect(titles(rr)).To(Equal([]string{"Deploy api"}))
+			Expect(rr.Header().Get("X-Total-Count")).To(Equal("2"))
+			next := links(rr)["next"]
+			Expect(next).To(HavePrefix(runURL + "?"))
+			Expect(titles(do(http.MethodGet, next, nil))).To(Equal([]string{"Deploy web"}))
+		})
+
+		It("defaults the columns and lists views by name", func() {
+			view := createView(map[string]any{"name": "All tasks"})
+			Expect(view.Filter).To(BeNil())
+			Expect(view.Sort).To(BeNil())
+			Expect(view.Columns).To(Equal([]scheme.ViewColumn{scheme.ViewColumnTitle, scheme.ViewColumnStatus, scheme.ViewColumnUpdatedAt}))
+
+			rr := do(http.MethodGet, viewsURL, nil)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			var views []scheme.View
+			readJSON(rr, &views)
+			names := make([]string, 0, len(views))
+			for _, v := range views {
+				names = append(names, v.Name)
+			}
+			Expect(names).To(Equal([]string{"All tasks", "Open deploys"}))
+
+			Expect(titles(do(http.MethodGet, fmt.Sprintf("%s/%s/tasks", viewsURL, view.Id), nil))).To(HaveLen(4))
+		})
+
+		It("keeps view names unique within a project", func() {
+			rr := do(http.MethodPost, viewsURL, map[string]any{"name": "All tasks"})
+			Expect(rr.Code).To(Equal(http.StatusConflict))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("VIEW_NAME_EXISTS"))
+
+			rr = do(http.MethodPost, "/projects/"+seedProjectID+"/views", map[string]any{"name": "All tasks"})
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+		})
+
+		It("refuses filters and sorts that could not run", func() {
+			for field, body := range map[string]map[string]any{
+				"filter": {"name": "Broken", "filter": "priority = 1"},
+				"sort":   {"name": "Broken", "sort": "title,-title"},
+			} {
+				rr := do(http.MethodPost, viewsURL, body)
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity), field)
+				var got scheme.Error
+				readJSON(rr, &got)
+				Expect(got.Code).To(Equal("VALIDATION_FAILED"))
+				Expect(*got.Details).To(ContainElement(HaveField("Field", field)))
+			}
+		})
+
+		It("updates and clears view fields", func() {
+			view := createView(map[string]any{"name": "Todo", "filter": "status = TODO", "sort": "-title"})
+			viewURL := fmt.Sprintf("%s/%s", viewsURL, view.Id)
+			Expect(titles(do(http.MethodGet, viewURL+"/tasks", nil))).To(Equal([]string{"Write docs", "Deploy web"}))
+
+			rr := do(http.MethodPut, viewURL, map[string]any{"name": "Everything", "filter": "", "columns": []string{"id"}})
+			Expect(rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+			var updated scheme.View
+			readJSON(rr, &updated)
+			Expect(updated.Name).To(Equal("Everything"))
+			Expect(updated.Filter).To(BeNil())
+			Expect(*updated.Sort).To(Equal("-title"))
+			Expect(updated.Columns).To(Equal([]scheme.ViewColumn{scheme.ViewColumnId}))
+			Expect(updated.UpdatedAt).To(BeTemporally(">=", view.UpdatedAt))
+
+			Expect(titles(do(http.MethodGet, viewURL+"/tasks", nil))).To(Equal([]string{"Write docs", "Deploy web", "Deploy db", "Deploy api"}))
+
+			rr = do(http.MethodPut, viewURL, map[string]any{"filter": "status ="})
+			Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+			rr = do(http.MethodPut, viewURL, map[string]any{"name": "All tasks"})
+			Expect(rr.Code).To(Equal(http.StatusConflict))
+
+			rr = do(http.MethodGet, viewURL, nil)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			var got scheme.View
+			readJSON(rr, &got)
+			Expect(got.Name).To(Equal("Everything"))
+		})
+
+		It("deletes views", func() {
+			view := createView(map[string]any{"name": "Short lived"})
+			viewURL := fmt.Sprintf("%s/%s", viewsURL, view.Id)
+
+			Expect(do(http.MethodDelete, viewURL, nil).Code).To(Equal(http.StatusNoContent))
+			for _, url := range []string{viewURL, viewURL + "/tasks"} {
+				rr := do(http.MethodGet, url, nil)
+				Expect(rr.Code).To(Equal(http.StatusNotFound))
+				var got scheme.Error
+				readJSON(rr, &got)
+				Expect(got.Code).To(Equal("VIEW_NOT_FOUND"))
+			}
+			Expect(do(http.MethodDelete, viewURL, nil).Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("returns 404 for views of unknown projects", func() {
+			Expect(do(http.MethodGet, "/projects/"+invalidProjectID+"/views", nil).Code).To(Equal(http.StatusNotFound))
+			Expect(do(http.MethodPost, "/projects/"+invalidProjectID+"/views", map[string]any{"name": "x"}).Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("Labels", func() {
+		var (
+			projectURL string
+			labelsURL  string
+			tasks      = map[string]scheme.Task{}
+		)
+
+		BeforeAll(func() {
+			rr := do(http.MethodPost, "/projects", map[string]any{"name": "Labels host"})
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			var project scheme.Project
+			readJSON(rr, &project)
+			projectURL = "/projects/" + project.Id.String()
+			labelsURL = projectURL + "/labels"
+
+			for _, title := range []string{"Crash on save", "Slow search", "New theme"} {
+				rr := do(http.MethodPost, projectURL+"/tasks", map[string]any{"title": title})
+				Expect(rr.Code).To(Equal(http.StatusCreated))
+				var task scheme.Task
+				readJSON(rr, &task)
+				tasks[title] = task
+			}
+		})
+
+		createLabel := func(name, color string) scheme.Label {
+			rr := do(http.MethodPost, labelsURL, map[string]any{"name": name, "color": color})
+			ExpectWithOffset(1, rr.Code).To(Equal(http.StatusCreated), rr.Body.String())
+			var label scheme.Label
+			readJSON(rr, &label)
+			return label
+		}
+		labelTask := func(title string, label scheme.Label) *httptest.ResponseRecorder {
+			return do(http.MethodPut, fmt.Sprintf("%s/tasks/%s/labels/%s", projectURL, tasks[title].Id, label.Id), nil)
+		}
+		labelNames := func(title string) []string {
+			var task scheme.Task
+			readJSON(do(http.MethodGet, fmt.Sprintf("%s/tasks/%s", projectURL, tasks[title].Id), nil), &task)
+			var out []string
+			if task.Labels != nil {
+				for _, l := range *task.Labels {
+					out = append(out, l.Name)
+				}
+			}
+			return out
+		}
+		version := func(title string) int {
+			var task scheme.Task
+			readJSON(do(http.MethodGet, fmt.Sprintf("%s/tasks/%s", projectURL, tasks[title].Id), nil), &task)
+			return task.Version
+		}
+		titles := func(query string) []string {
+			rr := do(http.MethodGet, projectURL+"/tasks?sort=title&"+query, nil)
+			ExpectWithOffset(1, rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+			var list []scheme.Task
+			readJSON(rr, &list)
+			out := []string{}
+			for _, t := range list {
+				out = append(out, t.Title)
+			}
+			return out
+		}
+		counts := func() map[string]int {
+			var labels []scheme.Label
+			readJSON(do(http.MethodGet, labelsURL, nil), &labels)
+			out := map[string]int{}
+			for _, l := range labels {
+				out[l.Name] = l.TaskCount
+			}
+			return out
+		}
+		errorCode := func(rr *httptest.ResponseRecorder) scheme.Error {
+			var apiErr scheme.Error
+			readJSON(rr, &apiErr)
+			return apiErr
+		}
+
+		It("creates labels with names unique regardless of case", func() {
+			bug := createLabel("bug", "#D73A4A")
+			Expect(bug.Color).To(Equal("#d73a4a"))
+			Expect(bug.TaskCount).To(Equal(0))
+			createLabel("perf", "#fbca04")
+			createLabel("ui", "#0075ca")
+
+			rr := do(http.MethodPost, labelsURL, map[string]any{"name": "BUG", "color": "#000000"})
+			Expect(rr.Code).To(Equal(http.StatusConflict))
+			Expect(errorCode(rr).Code).To(Equal("LABEL_NAME_EXISTS"))
+
+			rr = do(http.MethodPost, labelsURL, map[string]any{"name": "   ", "color": "#000000"})
+			Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+			Expect(errorCode(rr).Code).To(Equal("VALIDATION_FAILED"))
+
+			rr = do(http.MethodPost, labelsURL, map[string]any{"name": "red", "color": "red"})
+			Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+
+			rr = do(http.MethodGet, labelsURL, nil)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			var labels []scheme.Label
+			readJSON(rr, &labels)
+			Expect(labels).To(HaveLen(3))
+			Expect(labels[0].Name).To(Equal("bug"))
+			Expect(labels[2].Name).To(Equal("ui"))
+
+			rr = do(http.MethodGet, labelsURL+"/"+bug.Id.String(), nil)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+		})
+
+		It("puts labels on tasks and counts them", func() {
+			var labels []scheme.Label
+			readJSON(do(http.MethodGet, labelsURL, nil), &labels)
+			bug, perf, ui := labels[0], labels[1], labels[2]
+
+			rr := labelTask("Crash on save", bug)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Header().Get("ETag")).To(Equal(`"2"`))
+			var task scheme.Task
+			readJSON(rr, &task)
+			Expect(task.Labels).To(HaveValue(Equal([]scheme.TaskLabel{{Id: bug.Id, Name: "bug", Color: "#d73a4a"}})))
+			Expect(task.Version).To(Equal(2))
+			rr = doWith(http.MethodGet, fmt.Sprintf("%s/tasks/%s", projectURL, task.Id), nil, map[string]string{"If-None-Match": `"1"`})
+			Expect(rr.Code).To(Equal(http.StatusOK))
+
+			Expect(labelTask("Crash on save", bug).Code).To(Equal(http.StatusOK))
+			Expect(version("Crash on save")).To(Equal(2))
+			Expect(labelTask("Crash on save", ui).Code).To(Equal(http.StatusOK))
+			Expect(version("Crash on save")).To(Equal(3))
+			Expect(labelTask("Slow search", bug).Code).To(Equal(http.StatusOK))
+			Expect(labelTask("Slow search", perf).Code).To(Equal(http.StatusOK))
+			Expect(labelTask("New theme", ui).Code).To(Equal(http.StatusOK))
+
+			Expect(labelNames("Crash on save")).To(Equal([]string{"bug", "ui"}))
+			Expect(counts()).To(Equal(map[string]int{"bug": 2, "perf": 1, "ui": 2}))
+
+			rr = do(http.MethodGet, labelsURL+"/"+bug.Id.String(), nil)
+			var got scheme.Label
+			readJSON(rr, &got)
+			Expect(got.TaskCount).To(Equal(2))
+		})
+
+		It("filters tasks by any or all of several labels", func() {
+			Expect(titles("label=bug")).To(Equal([]string{"Crash on save", "Slow search"}))
+			Expect(titles("label=BUG&label=ui")).To(Equal([]string{"Crash on save", "New theme", "Slow search"}))
+			Expect(titles("label=bug&label=ui&labelMatch=any")).To(HaveLen(3))
+			Expect(titles("label=bug&label=ui&labelMatch=all")).To(Equal([]string{"Crash on save"}))
+			Expect(titles("label=bug&label=Bug&labelMatch=all")).To(Equal([]string{"Crash on save", "Slow search"}))
+			Expect(titles("label=bug&label=nope&labelMatch=all")).To(BeEmpty())
+			Expect(titles("label=perf&status=TODO")).To(Equal([]string{"Slow search"}))
+
+			rr := do(http.MethodGet, projectURL+"/tasks?label=bug&labelMatch=some", nil)
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+
+			for _, query := range []string{"label=", "label=%20", "label=bug&label="} {
+				rr = do(http.MethodGet, projectURL+"/tasks?"+query, nil)
+				Expect(rr.Code).To(Equal(http.StatusBadRequest), query)
+				Expect(errorCode(rr).Code).To(Equal("INVALID_PARAMETER"))
+			}
+
+			rr = do(http.MethodGet, "/tasks?sort=title&label=bug&label=ui&labelMatch=all&projectId="+strings.TrimPrefix(projectURL, "/projects/"), nil)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			var across []scheme.ProjectTask
+			readJSON(rr, &across)
+			Expect(across).To(HaveLen(1))
+			Expect(across[0].Title).To(Equal("Crash on save"))
+			Expect(do(http.MethodGet, "/tasks?label=", nil).Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("shows renames on the tasks and keeps filtering by the new name", func() {
+			var labels []scheme.Label
+			readJSON(do(http.MethodGet, labelsURL, nil), &labels)
+			ui := labels[2]
+
+			rr := do(http.MethodPut, labelsURL+"/"+ui.Id.String(), map[string]any{"name": "design", "color": "#A2EEEF"})
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			var renamed scheme.Label
+			readJSON(rr, &renamed)
+			Expect(renamed.Name).To(Equal("design"))
+			Expect(renamed.Color).To(Equal("#a2eeef"))
+			Expect(renamed.TaskCount).To(Equal(2))
+
+			Expect(labelNames("New theme")).To(Equal([]string{"design"}))
+			Expect(titles("label=design")).To(Equal([]string{"Crash on save", "New theme"}))
+			Expect(titles("label=ui")).To(BeEmpty())
+			Expect(version("Crash on save")).To(Equal(4))
+			Expect(version("New theme")).To(Equal(3))
+			Expect(version("Slow search")).To(Equal(3))
+
+			rr = do(http.MethodPut, labelsURL+"/"+ui.Id.String(), map[string]any{"name": "Perf"})
+			Expect(rr.Code).To(Equal(http.StatusConflict))
+			Expect(errorCode(rr).Code).To(Equal("LABEL_NAME_EXISTS"))
+			Expect(version("Crash on save")).To(Equal(4))
+		})
+
+		It("takes labels off tasks", func() {
+			var labels []scheme.Label
+			readJSON(do(http.MethodGet, labelsURL, nil), &labels)
+			perf := labels[2]
+			Expect(perf.Name).To(Equal("perf"))
+
+			url := fmt.Sprintf("%s/tasks/%s/labels/%s", projectURL, tasks["Slow search"].Id, perf.Id)
+			Expect(do(http.MethodDelete, url, nil).Code).To(Equal(http.StatusNoContent))
+			Expect(labelNames("Slow search")).To(Equal([]string{"bug"}))
+			Expect(version("Slow search")).To(Equal(4))
+
+			rr := do(http.MethodDelete, url, nil)
+			Expect(rr.Code).To(Equal(http.StatusNotFound))
+			Expect(errorCode(rr).Code).To(Equal("LABEL_NOT_FOUND"))
+		})
+
+		It("deletes a label from the catalog and from its tasks", func() {
+			var labels []scheme.Label
+			readJSON(do(http.MethodGet, labelsURL, nil), &labels)
+			bug := labels[0]
+
+			Expect(do(http.MethodDelete, labelsURL+"/"+bug.Id.String(), nil).Code).To(Equal(http.StatusNoContent))
+			Expect(labelNames("Crash on save")).To(Equal([]string{"design"}))
+			Expect(labelNames("Slow search")).To(BeNil())
+			Expect(titles("label=bug")).To(BeEmpty())
+			Expect(version("Crash on save")).To(Equal(5))
+			Expect(version("Slow search")).To(Equal(5))
+			Expect(version("New theme")).To(Equal(3))
+
+			rr := do(http.MethodGet, labelsURL+"/"+bug.Id.String(), nil)
+			Expect(rr.Code).To(Equal(http.StatusNotFound))
+			Expect(errorCode(rr).Code).To(Equal("LABEL_NOT_FOUND"))
+			Expect(do(http.MethodDelete, labelsURL+"/"+bug.Id.String(), nil).Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("only puts labels of the task's own project on it", func() {
+			rr := do(http.MethodPost, "/projects", map[string]any{"name": "Labels elsewhere"})
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			var other scheme.Project
+			readJSON(rr, &other)
+			rr = do(http.MethodPost, fmt.Sprintf("/projects/%s/labels", other.Id), map[string]any{"name": "foreign", "color": "#123456"})
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			var foreign scheme.Label
+			readJSON(rr, &foreign)
+
+			rr = labelTask("New theme", foreign)
+			Expect(rr.Code).To(Equal(http.StatusNotFound))
+			Expect(errorCode(rr).Code).To(Equal("LABEL_NOT_FOUND"))
+
+			rr = do(http.MethodGet, fmt.Sprintf("/projects/%s/labels", invalidProjectID), nil)
+			Expect(rr.Code).To(Equal(http.StatusNotFound))
+			Expect(errorCode(rr).Code).To(Equal("PROJECT_NOT_FOUND"))
+		})
+	})
+
+	Describe("Idempotency keys", func() {
+		withKey := func(key string) map[string]string { return map[string]string{"Idempotency-Key": key} }
+		countTasks := func(title string) int {
+			var n int
+			Expect(db.QueryRow(`SELECT COUNT(*) FROM tasks WHERE title = ?`, title).Scan(&n)).To(Succeed())
+			return n
+		}
+		tasksURL := "/projects/" + seedProjectID + "/tasks"
+
+		It("replays the first response to retries", func() {
+			first := doWith(http.MethodPost, "/projects", map[string]any{"name": "Retried"}, withKey("retry-project"))
+			Expect(first.Code).To(Equal(http.StatusCreated))
+			Expect(first.Header().Get("Idempotent-Replayed")).To(BeEmpty())
+
+			again := doWith(http.MethodPost, "/projects", map[string]any{"name": "Retried"}, withKey("retry-project"))
+			Expect(again.Code).To(Equal(http.StatusCreated))
+			Expect(again.Header().Get("Idempotent-Replayed")).To(Equal("true"))
+			Expect(again.Header().Get("ETag")).To(Equal(first.Header().Get("ETag")))
+			Expect(again.Header().Get("Content-Type")).To(HavePrefix("application/json"))
+			Expect(again.Body.String()).To(Equal(first.Body.String()))
+
+			var n int
+			Expect(db.QueryRow(`SELECT COUNT(*) FROM projects WHERE name = 'Retried'`).Scan(&n)).To(Succeed())
+			Expect(n).To(Equal(1))
+		})
+
+		It("compares JSON bodies by value", func() {
+			post := func(body string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodPost, tasksURL, strings.NewReader(body))
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set("Idempotency-Key", "retry-reencoded")
+				rr := httptest.NewRecorder()
+				handler.ServeHTTP(rr, req)
+				return rr
+			}
+			Expect(post(`{"title":"Re-encoded","status":"TODO"}`).Code).To(Equal(http.StatusCreated))
+			rr := post(`{ "status": "TODO",  "title": "Re-encoded" }`)
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			Expect(rr.Header().Get("Idempotent-Replayed")).To(Equal("true"))
+			Expect(countTasks("Re-encoded")).To(Equal(1))
+		})
 
+		It("rejects a key reused for a different request", func() {
+			Expect(doWith(http.MethodPost, tasksURL, map[string]any{"title": "Original"}, withKey("reused")).Code).To(Equal(http.StatusCreated))
+
+			for _, rr := range []*httptest.ResponseRecorder{
+				doWith(http.MethodPost, tasksURL, map[string]any{"title": "Different"}, withKey("reused")),
+				doWith(http.MethodPost, "/projects", map[string]any{"name": "Original"}, withKey("reused")),
+			} {
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+				var got scheme.Error
+				readJSON(rr, &got)
+				Expect(got.Code).To(Equal("IDEMPOTENCY_KEY_REUSED"))
+			}
+			Expect(countTasks("Different")).To(BeZero())
+		})
+
+		It("releases the key of a failed request", func() {
+			rr := doWith(http.MethodPost, "/projects/"+invalidProjectID+"/tasks", map[string]any{"title": "Lost"}, withKey("failed"))
+			Expect(rr.Code).To(Equal(http.StatusNotFound))
+
+			rr = doWith(http.MethodPost, tasksURL, map[string]any{"title": "Found"}, withKey("failed"))
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			Expect(rr.Header().Get("Idempotent-Replayed")).To(BeEmpty())
+		})
+
+		It("answers 409 while the first request is still running", func() {
+			_, err := db.Exec(`INSERT INTO idempotency_keys (key, fingerprint, created_at, expires_at) VALUES ('running', 'x', ?, ?)`,
+				helpers.FormatTime(time.Now()), helpers.FormatTime(time.Now().Add(time.Hour)))
+			Expect(err).NotTo(HaveOccurred())
+
+			rr := doWith(http.MethodPost, "/projects", map[string]any{"name": "Busy"}, withKey("running"))
+			// A different fingerprint wins over the running request.
+			Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+
+			_, err = db.Exec(`UPDATE idempotency_keys SET fingerprint = (SELECT fingerprint FROM idempotency_keys WHERE key = 'retry-project') WHERE key = 'running'`)
+			Expect(err).NotTo(HaveOccurred())
+			rr = doWith(http.MethodPost, "/projects", map[string]any{"name": "Retried"}, withKey("running"))
+			Expect(rr.Code).To(Equal(http.StatusConflict))
+			Expect(rr.Header().Get("Retry-After")).To(Equal("1"))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("IDEMPOTENCY_KEY_IN_PROGRESS"))
+		})
+
+		It("runs concurrent duplicates once", func() {
+			const n = 8
+			codes := make(chan *httptest.ResponseRecorder, n)
+			var wg sync.WaitGroup
+			for range n {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					codes <- doWith(http.MethodPost, tasksURL, map[string]any{"title": "Raced"}, withKey("raced"))
+				}()
+			}
+			wg.Wait()
+			close(codes)
+
+			fresh := 0
+			for rr := range codes {
+				Expect(rr.Code).To(BeElementOf(http.StatusCreated, http.StatusConflict))
+				if rr.Code == http.StatusCreated && rr.Header().Get("Idempotent-Replayed") == "" {
+					fresh++
+				}
+			}
+			Expect(fresh).To(Equal(1))
+			Expect(countTasks("Raced")).To(Equal(1))
+		})
+
+		It("forgets keys once they expire", func() {
+			_, err := db.Exec(`UPDATE idempotency_keys SET expires_at = ? WHERE key = 'reused'`, helpers.FormatTime(time.Now().Add(-time.Second)))
+			Expect(err).NotTo(HaveOccurred())
+
+			rr := doWith(http.MethodPost, tasksURL, map[string]any{"title": "Different"}, withKey("reused"))
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			Expect(rr.Header().Get("Idempotent-Replayed")).To(BeEmpty())
+
+			purged, err := idempotencyKeys.DeleteExpired(context.Background(), time.Now().Add(2*time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(purged).To(BeNumerically(">=", 6))
+			var left int
+			Expect(db.QueryRow(`SELECT COUNT(*) FROM idempotency_keys`).Scan(&left)).To(Succeed())
+			Expect(left).To(BeZero())
+		})
+	})
+
+	Describe("Batch", func() {
+		batch := func(body any) (*httptest.ResponseRecorder, scheme.BatchResponse) {
+			rr := do(http.MethodPost, "/batch", body)
+			var res scheme.BatchResponse
+			if rr.Code == http.StatusOK || rr.Code == http.StatusMultiStatus || rr.Code == http.StatusUnprocessableEntity {
+				_ = json.Unmarshal(rr.Body.Bytes(), &res)
+			}
+			return rr, res
+		}
+		statuses := func(res scheme.BatchResponse) []int {
+			var out []int
+			for _, r := range res.Responses {
+				out = append(out, r.Status)
+			}
+			return out
+		}
+		member := func(r scheme.BatchSubResponse, name string) any {
+			body, ok := r.Body.(map[string]any)
+			ExpectWithOffset(1, ok).To(BeTrue(), "body=%v", r.Body)
+			return body[name]
+		}
+
+		It("runs sub-requests in order, resolving references to earlier responses", func() {
+			rr, res := batch(map[string]any{"requests": []map[string]any{
+				{"id": "project", "method": "POST", "path": "/projects", "body": map[string]any{"name": "Batched"}},
+				{"id": "task", "method": "POST", "path": "/projects/${project.body.id}/tasks", "body": map[string]any{
+					"title":       "Plan ${project.body.name}",
+					"description": "${project.body.name}",
+				}},
+				{"method": "PUT", "path": "/projects/${project.body.id}/tasks/${task.body.id}",
+					"headers": map[string]string{"If-Match": "${task.headers.ETag}"},
+					"body":    map[string]any{"status": "DONE"}},
+				{"method": "GET", "path": "/projects/${project.body.id}/tasks?status=DONE"},
+			}})
+			Expect(rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+			Expect(res.Committed).To(BeTrue())
+			Expect(res.Succeeded).To(Equal(4))
+			Expect(statuses(res)).To(Equal([]int{201, 201, 200, 200}))
+
+			Expect(*res.Responses[0].Id).To(Equal("project"))
+			Expect(res.Responses[1].Headers).To(HaveKeyWithValue("Etag", `"1"`))
+			Expect(member(res.Responses[1], "title")).To(Equal("Plan Batched"))
+			Expect(member(res.Responses[1], "description")).To(Equal("Batched"))
+			Expect(member(res.Responses[2], "status")).To(Equal("DONE"))
+			Expect(res.Responses[2].Headers).To(HaveKeyWithValue("Etag", `"2"`))
+			listed, ok := res.Responses[3].Body.([]any)
+			Expect(ok).To(BeTrue())
+			Expect(listed).To(HaveLen(1))
+		})
+
+		It("keeps the sub-requests that succeed when others fail", func() {
+			rr, res := batch(map[string]any{"requests": []map[string]any{
+				{"id": "bad", "method": "POST", "path": "/projects", "body": map[string]any{}},
+				{"method": "GET", "path": "/projects/${bad.body.id}"},
+				{"method": "GET", "path": "/projects/${nope.body.id}"},
+				{"id": "good", "method": "POST", "path": "/projects", "body": map[string]any{"name": "Batched kept"}},
+				{"method": "GET", "path": "/projects/${good.body.id}/tasks/${good.body.missing}"},
+				{"method": "DELETE", "path": "/projects/${good.body.id}"},
+			}})
+			Expect(rr.Code).To(Equal(http.StatusMultiStatus), rr.Body.String())
+			Expect(res.Committed).To(BeTrue())
+			Expect(res.Succeeded).To(Equal(2))
+			Expect(res.Failed).To(Equal(4))
+			Expect(statuses(res)).To(Equal([]int{422, 424, 422, 201, 422, 204}))
+			Expect(member(res.Responses[1], "code")).To(Equal("DEPENDENCY_FAILED"))
+			Expect(member(res.Responses[2], "code")).To(Equal("INVALID_REFERENCE"))
+			Expect(member(res.Responses[4], "code")).To(Equal("INVALID_REFERENCE"))
+			Expect(res.Responses[5].Body).To(BeNil())
+		})
+
+		It("rolls a transactional batch back at the first failing sub-request", func() {
+			rr, res := batch(map[string]any{"transactional": true, "requests": []map[string]any{
+				{"id": "project", "method": "POST", "path": "/projects", "body": map[string]any{"name": "Batched rollback"}},
+				{"method": "POST", "path": "/projects/${project.body.id}/tasks", "body": map[string]any{"title": "Gone"}},
+				{"method": "GET", "path": "/projects/" + invalidProjectID},
+				{"method": "GET", "path": "/projects"},
+			}})
+			Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity), rr.Body.String())
+			Expect(res.Committed).To(BeFalse())
+			Expect(res.Succeeded).To(BeZero())
+			Expect(statuses(res)).To(Equal([]int{424, 424, 404, 424}))
+			Expect(*res.Responses[0].Id).To(Equal("project"))
+			Expect(member(res.Responses[0], "code")).To(Equal("BATCH_ABORTED"))
+			Expect(member(res.Responses[2], "code")).To(Equal("PROJECT_NOT_FOUND"))
+
+			Expect(do(http.MethodPost, "/projects", map[string]any{"name": "Batched rollback"}).Code).To(Equal(http.StatusCreated))
+		})
+
+		It("commits a transactional batch that succeeds", func() {
+			rr, res := batch(map[string]any{"transactional": true, "requests": []map[string]any{
+				{"id": "project", "method": "POST", "path": "/projects", "body": map[string]any{"name": "Batched together"}},
+				{"method": "POST", "path": "/projects/${project.body.id}/tasks", "body": map[string]any{"title": "Kept"}},
+			}})
+			Expect(rr.Code).To(Equal(http.StatusOK), rr.Body.String())
+			Expect(res.Committed).To(BeTrue())
+
+			rr = do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks", member(res.Responses[0], "id")), nil)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Body.String()).To(ContainSubstring(`"Kept"`))
+		})
+
+		It("rejects invalid batches before running any sub-request", func() {
+			tooMany := make([]map[string]any, config.Default().Limits.BatchMaxRequests+1)
+			for i := range tooMany {
+				tooMany[i] = map[string]any{"method": "GET", "path": "/projects"}
+			}
+			for _, tc := range []struct {
+				requests []map[string]any
+				field    string
+			}{
+				{[]map[string]any{{"method": "POST", "path": "/batch", "body": map[string]any{}}}, "requests.0.path"},
+				{[]map[string]any{{"method": "GET", "path": "//example.com/projects"}}, "requests.0.path"},
+				{[]map[string]any{{"id": "x", "method": "GET", "path": "/projects"}, {"id": "x", "method": "GET", "path": "/projects"}}, "requests.1.id"},
+				{tooMany, "requests"},
+			} {
+				rr := do(http.MethodPost, "/batch", map[string]any{"requests": tc.requests})
+				Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity), tc.field)
+				var apiErr scheme.Error
+				readJSON(rr, &apiErr)
+				Expect(apiErr.Code).To(Equal("VALIDATION_FAILED"))
+				Expect((*apiErr.Details)[0].Field).To(Equal(tc.field))
+			}
+		})
+	})
+
+	Describe("Metrics", func() {
+		It("labels requests with the ServerInterface operation", func() {
+			ops, err := api.OperationIDs("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ops).To(HaveKeyWithValue("GET /projects/{projectId}/tasks/{taskId}", "GetTask"))
+
+			reg := metrics.NewRegistry()
+			m := metrics.NewHTTP(reg)
+			measured := middleware.MetricsMiddleware(m, func(r *http.Request) string { return api.Operation(ops, r) })(handler)
+
+			for _, path := range []string{"/projects", "/projects/" + invalidProjectID, "/nowhere"} {
+				measured.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+			}
+
+			var out strings.Builder
+			Expect(reg.Write(context.Background(), &out)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring(`http_requests_total{operation="ListProjects",method="GET",code="200"} 1`))
+			Expect(out.String()).To(ContainSubstring(`http_requests_total{operation="GetProject",method="GET",code="404"} 1`))
+			Expect(out.String()).To(ContainSubstring(`http_requests_total{operation="unmatched",method="GET",code="404"} 1`))
+			Expect(out.String()).To(ContainSubstring(`http_request_duration_seconds_count{operation="ListProjects",method="GET"} 1`))
+			Expect(out.String()).To(ContainSubstring("http_requests_in_flight 0"))
+		})
+	})
+
+	Describe("Tracing", func() {
+		It("records spans from the handler down to each SQL statement", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "spans.jsonl")
+			exp, err := telemetry.NewExporter(telemetry.ExporterOTLPFile, path, "todo-test")
+			Expect(err).NotTo(HaveOccurred())
+			tracer := telemetry.NewTracer(exp, nil)
+			telemetry.SetDefault(tracer)
+			DeferCleanup(telemetry.SetDefault, (*telemetry.Tracer)(nil))
+
+			ops, err := api.OperationIDs("")
+			Expect(err).NotTo(HaveOccurred())
+			traced := middleware.RequestIDMiddleware(
+				middleware.TracingMiddleware(func(r *http.Request) string { return api.Operation(ops, r) })(handler),
+			)
+			rr := httptest.NewRecorder()
+			traced.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/projects/"+seedProjectID+"/tasks", nil))
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(tracer.Shutdown(context.Background())).To(Succeed())
+
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			byName := map[string]telemetry.OTLPSpan{}
+			for _, line := range bytes.Split(bytes.TrimSpace(data), []byte("\n")) {
+				var msg telemetry.OTLPTraces
+				Expect(json.Unmarshal(line, &msg)).To(Succeed())
+				span := msg.ResourceSpans[0].ScopeSpans[0].Spans[0]
+				byName[span.Name] = span
+			}
+
+			root := byName["GET ListTasks"]
+			list := byName["TaskService.ListTasks"]
+			ensure := byName["ProjectsService.EnsureProjectExists"]
+			Expect(root.SpanID).NotTo(BeEmpty())
+			Expect(rr.Header().Get("traceparent")).To(ContainSubstring(root.TraceID + "-" + root.SpanID))
+			Expect(list.ParentSpanID).To(Equal(root.SpanID))
+			Expect(ensure.ParentSpanID).To(Equal(list.SpanID))
+			Expect(byName["SELECT"].TraceID).To(Equal(root.TraceID))
+			Expect(byName["write response"].ParentSpanID).To(Equal(root.SpanID))
+		})
+	})
+
+	Describe("Errors", func() {
+		It("reports a stable code with field details", func() {
+			rr := do(http.MethodPost, "/projects", map[string]any{"name": ""})
+			Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+			Expect(rr.Header().Get("Content-Type")).To(HavePrefix("application/json"))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("VALIDATION_FAILED"))
+			Expect(got.Details).NotTo(BeNil())
+			Expect(*got.Details).To(ContainElement(scheme.ErrorDetail{Field: "name", Message: "minimum string length is 1"}))
+
+			rr = do(http.MethodPost, "/projects", map[string]any{"name": strings.Repeat("n", 129)})
+			Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+			readJSON(rr, &got)
+			Expect(*got.Details).To(ContainElement(scheme.ErrorDetail{Field: "name", Message: "maximum string length is 128"}))
+		})
+
+		It("reports an invalid path parameter as INVALID_PARAMETER", func() {
+			rr := do(http.MethodGet, "/projects/not-a-uuid", nil)
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("INVALID_PARAMETER"))
+			Expect(*got.Details).To(ContainElement(HaveField("Field", "projectId")))
+		})
+
+		It("reports a malformed body as MALFORMED_REQUEST", func() {
+			req := httptest.NewRequest(http.MethodPost, "/projects", strings.NewReader(`{"name":`))
+			req.Header.Set("Content-Type", "application/json")
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("MALFORMED_REQUEST"))
+		})
+
+		It("rejects bodies in an undeclared media type with 415", func() {
+			req := httptest.NewRequest(http.MethodPost, "/projects", strings.NewReader("name=Form"))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			Expect(rr.Code).To(Equal(http.StatusUnsupportedMediaType))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("UNSUPPORTED_MEDIA_TYPE"))
+		})
+
+		It("rejects a missing required body before the handler runs", func() {
+			rr := do(http.MethodPost, "/projects", nil)
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("MALFORMED_REQUEST"))
+			Expect(got.Message).To(Equal("request body is required"))
+		})
+
+		It("reports every schema violation of a body with its field", func() {
+			url := fmt.Sprintf("/projects/%s/tasks", seedProjectID)
+			rr := do(http.MethodPost, url, map[string]any{
+				"title":  RandStringRunes(201),
+				"status": "LATER",
+			})
+			Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("VALIDATION_FAILED"))
+			Expect(*got.Details).To(ConsistOf(
+				HaveField("Field", "title"),
+				HaveField("Field", "status"),
+			))
+		})
+
+		It("reports a missing required property as is required", func() {
+			url := fmt.Sprintf("/projects/%s/tasks", seedProjectID)
+			rr := do(http.MethodPost, url, map[string]any{"status": "TODO"})
+			Expect(rr.Code).To(Equal(http.StatusUnprocessableEntity))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(*got.Details).To(ContainElement(scheme.ErrorDetail{Field: "title", Message: "is required"}))
+		})
+
+		It("reports a body of the wrong JSON type as MALFORMED_REQUEST", func() {
+			url := fmt.Sprintf("/projects/%s/tasks", seedProjectID)
+			rr := do(http.MethodPost, url, map[string]any{"title": 42})
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("MALFORMED_REQUEST"))
+			Expect(*got.Details).To(ContainElement(HaveField("Field", "title")))
+		})
+
+		It("rejects query parameters outside their schema", func() {
+			rr := do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks?status=LATER", seedProjectID), nil)
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("INVALID_PARAMETER"))
+			Expect(*got.Details).To(ContainElement(HaveField("Field", "status")))
+		})
+
+		It("uses the resource specific not found codes", func() {
+			rr := do(http.MethodGet, "/projects/"+invalidProjectID, nil)
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("PROJECT_NOT_FOUND"))
+
+			rr = do(http.MethodGet, fmt.Sprintf("/projects/%s/tasks/%s", seedProjectID, invalidProjectID), nil)
+			readJSON(rr, &got)
+			Expect(got.Code).To(Equal("TASK_NOT_FOUND"))
+		})
+
+		It("answers with application/problem+json when the client asks for it", func() {
+			req := httptest.NewRequest(http.MethodGet, "/projects/"+invalidProjectID, nil)
+			req.Header.Set("Accept", "application/problem+json")
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			Expect(rr.Code).To(Equal(http.StatusNotFound))
+			Expect(rr.Header().Get("Content-Type")).To(Equal("application/problem+json"))
+			var got scheme.Problem
+			readJSON(rr, &got)
+			Expect(got.Status).To(Equal(http.StatusNotFound))
+			Expect(got.Code).To(Equal("PROJECT_NOT_FOUND"))
+			Expect(got.Title).To(Equal("project not found"))
+			Expect(got.Type).To(Equal("urn:todo:problem:PROJECT_NOT_FOUND"))
+			Expect(*got.Instance).To(Equal("/projects/" + invalidProjectID))
+		})
+
+		It("carries the request's trace ID in error bodies and response headers", func() {
+			traced := middleware.RequestIDMiddleware(handler)
+			req := httptest.NewRequest(http.MethodGet, "/projects/"+invalidProjectID, nil)
+			req.Header.Set("X-Request-ID", "support-ticket-7")
+			req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+			rr := httptest.NewRecorder()
+			traced.ServeHTTP(rr, req)
+
+			Expect(rr.Code).To(Equal(http.StatusNotFound))
+			Expect(rr.Header().Get("X-Request-ID")).To(Equal("support-ticket-7"))
+			Expect(rr.Header().Get("traceparent")).To(HavePrefix("00-4bf92f3577b34da6a3ce929d0e0e4736-"))
+			var got scheme.Error
+			readJSON(rr, &got)
+			Expect(got.TraceId).NotTo(BeNil())
+			Expect(*got.TraceId).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
 		})
 	})
 })
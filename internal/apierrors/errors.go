@@ -1,13 +1,177 @@
 package apierrors
 
-import "errors"
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+)
+
+// Code is a stable, machine-readable error identifier. Codes are part of the
+// public API: add new ones freely, never rename or repurpose existing ones.
+type Code string
+
+const (
+	CodeValidationFailed         Code = "VALIDATION_FAILED"
+	CodeMalformedRequest         Code = "MALFORMED_REQUEST"
+	CodeInvalidParameter         Code = "INVALID_PARAMETER"
+	CodeUnsupportedMedia         Code = "UNSUPPORTED_MEDIA_TYPE"
+	CodeInvalidFilter            Code = "INVALID_FILTER"
+	CodeInvalidPatch             Code = "INVALID_PATCH"
+	CodePatchTestFailed          Code = "PATCH_TEST_FAILED"
+	CodeBulkAborted              Code = "BULK_ABORTED"
+	CodeBatchAborted             Code = "BATCH_ABORTED"
+	CodeInvalidReference         Code = "INVALID_REFERENCE"
+	CodeDependencyFailed         Code = "DEPENDENCY_FAILED"
+	CodeNotFound                 Code = "NOT_FOUND"
+	CodeProjectNotFound          Code = "PROJECT_NOT_FOUND"
+	CodeTaskNotFound             Code = "TASK_NOT_FOUND"
+	CodeViewNotFound             Code = "VIEW_NOT_FOUND"
+	CodeDependencyNotFound       Code = "DEPENDENCY_NOT_FOUND"
+	CodeLabelNotFound            Code = "LABEL_NOT_FOUND"
+	CodeProjectNameExists        Code = "PROJECT_NAME_EXISTS"
+	CodeViewNameExists           Code = "VIEW_NAME_EXISTS"
+	CodeLabelNameExists          Code = "LABEL_NAME_EXISTS"
+	CodeDependencyCycle          Code = "DEPENDENCY_CYCLE"
+	CodeTaskBlocked              Code = "TASK_BLOCKED"
+	CodePreconditionFailed       Code = "PRECONDITION_FAILED"
+	CodeIdempotencyKeyReused     Code = "IDEMPOTENCY_KEY_REUSED"
+	CodeIdempotencyKeyInProgress Code = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	CodeRequestCancelled         Code = "REQUEST_CANCELLED"
+	CodeInternal                 Code = "INTERNAL_ERROR"
+)
+
+// FieldError points at the part of the request that was rejected.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error is a domain error that knows how it is reported over HTTP.
+type Error struct {
+	Code    Code
+	Status  int
+	Message string
+	Details []FieldError
+
+	cause error
+}
+
+func New(code Code, status int, msg string, details ...FieldError) *Error {
+	return &Error{Code: code, Status: status, Message: msg, Details: details}
+}
+
+func (e *Error) Error() string { return e.Message }
+
+// Unwrap exposes the sentinel or underlying error this one was derived from,
+// so errors.Is(err, ErrProjectNotFound) keeps working on derived errors.
+func (e *Error) Unwrap() error { return e.cause }
+
+// WithDetails returns a copy of e carrying extra field details.
+func (e *Error) WithDetails(details ...FieldError) *Error {
+	out := *e
+	out.Details = append(append([]FieldError(nil), e.Details...), details...)
+	out.cause = e
+	return &out
+}
+
+// WithMessage returns a copy of e with a more specific message.
+func (e *Error) WithMessage(msg string) *Error {
+	out := *e
+	out.Message = msg
+	out.cause = e
+	return &out
+}
+
+// Wrap returns a copy of e that records err as its cause, for logging.
+func (e *Error) Wrap(err error) *Error {
+	out := *e
+	out.cause = errors.Join(e, err)
+	return &out
+}
 
 var (
-	ErrProjectNameRequired = errors.New("project name is required")
-	ErrProjectNameTooLong  = errors.New("project name is too long (max 128)")
-	ErrProjectNameExists   = errors.New("project name already exists")
-	ErrProjectNotFound     = errors.New("project not found")
-	ErrorTaskTitleNotFound = errors.New("title is required")
-	ErrTaskTitleTooLong    = errors.New("title too long (max 200)")
-	ErrorTaskStatusInvalid = errors.New("invalid status; use TODO|IN_PROGRESS|DONE")
+	ErrProjectNameRequired = New(CodeValidationFailed, http.StatusUnprocessableEntity, "project name is required", FieldError{"name", "is required"})
+	ErrProjectNameTooLong  = New(CodeValidationFailed, http.StatusUnprocessableEntity, "project name is too long", FieldError{"name", "is too long"})
+	ErrProjectNameExists   = New(CodeProjectNameExists, http.StatusConflict, "project name already exists", FieldError{"name", "already exists"})
+	ErrProjectNotFound     = New(CodeProjectNotFound, http.StatusNotFound, "project not found")
+	ErrTaskNotFound        = New(CodeTaskNotFound, http.StatusNotFound, "task not found")
+	ErrTaskTitleRequired   = New(CodeValidationFailed, http.StatusUnprocessableEntity, "title is required", FieldError{"title", "is required"})
+	ErrTaskTitleTooLong    = New(CodeValidationFailed, http.StatusUnprocessableEntity, "title too long", FieldError{"title", "is too long"})
+	ErrViewNotFound        = New(CodeViewNotFound, http.StatusNotFound, "view not found")
+	ErrViewNameRequired    = New(CodeValidationFailed, http.StatusUnprocessableEntity, "view name is required", FieldError{"name", "is required"})
+	ErrViewNameTooLong     = New(CodeValidationFailed, http.StatusUnprocessableEntity, "view name is too long", FieldError{"name", "is too long"})
+	ErrViewNameExists      = New(CodeViewNameExists, http.StatusConflict, "view name already exists in this project", FieldError{"name", "already exists"})
+	ErrTaskStatusInvalid   = New(CodeValidationFailed, http.StatusUnprocessableEntity, "invalid status; use TODO|IN_PROGRESS|DONE", FieldError{"status", "must be one of TODO, IN_PROGRESS, DONE"})
+	ErrLabelNotFound       = New(CodeLabelNotFound, http.StatusNotFound, "label not found")
+	ErrLabelNameRequired   = New(CodeValidationFailed, http.StatusUnprocessableEntity, "label name is required", FieldError{"name", "is required"})
+	ErrLabelNameExists     = New(CodeLabelNameExists, http.StatusConflict, "label name already exists in this project", FieldError{"name", "already exists"})
+	ErrDependencyNotFound  = New(CodeDependencyNotFound, http.StatusNotFound, "the task is not blocked by that task")
+	ErrDependencyCycle     = New(CodeDependencyCycle, http.StatusConflict, "the task already blocks that task, directly or through other tasks", FieldError{"blockerId", "would make the dependencies circular"})
+	ErrTaskBlocked         = New(CodeTaskBlocked, http.StatusConflict, "the task cannot start or finish while tasks blocking it are not done")
+
+	ErrNotFound                 = New(CodeNotFound, http.StatusNotFound, "resource not found")
+	ErrMalformedBody            = New(CodeMalformedRequest, http.StatusBadRequest, "invalid request body")
+	ErrInvalidParameter         = New(CodeInvalidParameter, http.StatusBadRequest, "invalid parameter")
+	ErrValidation               = New(CodeValidationFailed, http.StatusUnprocessableEntity, "request failed validation")
+	ErrInvalidFilter            = New(CodeInvalidFilter, http.StatusBadRequest, "invalid filter")
+	ErrInvalidPatch             = New(CodeInvalidPatch, http.StatusUnprocessableEntity, "the patch cannot be applied")
+	ErrBulkAborted              = New(CodeBulkAborted, http.StatusFailedDependency, "not applied: another task of the atomic bulk request failed")
+	ErrBatchAborted             = New(CodeBatchAborted, http.StatusFailedDependency, "not applied: another request of the transactional batch failed")
+	ErrInvalidReference         = New(CodeInvalidReference, http.StatusUnprocessableEntity, "a reference to an earlier request cannot be resolved")
+	ErrDependencyFailed         = New(CodeDependencyFailed, http.StatusFailedDependency, "not run: a request it refers to failed")
+	ErrPatchTestFailed          = New(CodePatchTestFailed, http.StatusConflict, "a test operation of the patch failed; the resource holds another value")
+	ErrIdempotencyKeyReused     = New(CodeIdempotencyKeyReused, http.StatusUnprocessableEntity, "Idempotency-Key was already used for a different request", FieldError{"Idempotency-Key", "was already used for a different request"})
+	ErrIdempotencyKeyInProgress = New(CodeIdempotencyKeyInProgress, http.StatusConflict, "a request with this Idempotency-Key is still being processed; retry later")
+	ErrPreconditionFailed       = New(CodePreconditionFailed, http.StatusPreconditionFailed, "the resource has changed since it was read; fetch it again and retry")
+	ErrUnsupportedMedia         = New(CodeUnsupportedMedia, http.StatusUnsupportedMediaType, "unsupported content type")
+	ErrCancelled                = New(CodeRequestCancelled, 499, "request cancelled")
+	ErrInternal                 = New(CodeInternal, http.StatusInternalServerError, "internal server error")
 )
+
+// InvalidParameter reports a path or query parameter that could not be used.
+func InvalidParameter(name, reason string) *Error {
+	return ErrInvalidParameter.
+		WithMessage("invalid " + name + ": " + reason).
+		WithDetails(FieldError{Field: name, Message: reason})
+}
+
+// InvalidField reports a request body field that parsed but broke a rule.
+func InvalidField(name, reason string) *Error {
+	return ErrValidation.
+		WithMessage("invalid " + name + ": " + reason).
+		WithDetails(FieldError{Field: name, Message: reason})
+}
+
+// InvalidFilter reports a filter expression that could not be parsed.
+func InvalidFilter(reason string) *Error {
+	return ErrInvalidFilter.
+		WithMessage("invalid filter: " + reason).
+		WithDetails(FieldError{Field: "filter", Message: reason})
+}
+
+// InvalidPatch reports a patch operation that could not be applied at path.
+func InvalidPatch(path, reason string) *Error {
+	return ErrInvalidPatch.
+		WithMessage("invalid patch: " + reason).
+		WithDetails(FieldError{Field: path, Message: reason})
+}
+
+// From maps any error onto the catalog. It is the single place that decides
+// how a failure is reported; unknown errors become INTERNAL_ERROR and keep the
+// original error as cause so it can be logged.
+func From(err error) *Error {
+	var apiErr *Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &apiErr):
+		return apiErr
+	case errors.Is(err, sql.ErrNoRows):
+		return ErrNotFound.Wrap(err)
+	case errors.Is(err, context.Canceled):
+		return ErrCancelled.Wrap(err)
+	default:
+		return ErrInternal.Wrap(err)
+	}
+}
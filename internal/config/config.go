@@ -0,0 +1,406 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix prefixes every environment variable read by Load.
+const EnvPrefix = "TODO_"
+
+type Config struct {
+	Server      Server      `yaml:"server"`
+	CORS        CORS        `yaml:"cors"`
+	DB          DB          `yaml:"db"`
+	Limits      Limits      `yaml:"limits"`
+	Tasks       Tasks       `yaml:"tasks"`
+	Idempotency Idempotency `yaml:"idempotency"`
+	Log         Log         `yaml:"log"`
+	Tracing     Tracing     `yaml:"tracing"`
+}
+
+type Server struct {
+	Address string `yaml:"address"`
+	// ShutdownTimeout bounds how long in-flight requests may drain on SIGTERM.
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout"`
+}
+
+type CORS struct {
+	// AllowedOrigins lists origins echoed back in Access-Control-Allow-Origin,
+	// with credentials allowed. A "*" allows any other origin, without them.
+	AllowedOrigins []string `yaml:"allowedOrigins"`
+}
+
+type DB struct {
+	DSN          string        `yaml:"dsn"`
+	BusyTimeout  time.Duration `yaml:"busyTimeout"`
+	MaxReadConns int           `yaml:"maxReadConns"`
+}
+
+// The bounds the API spec puts on titles, project names and the limit
+// parameter. Request validation enforces them before any configured limit.
+const (
+	specTitleMaxLength       = 200
+	specProjectNameMaxLength = 128
+	specMaxPageSize          = 200
+)
+
+type Limits struct {
+	// TitleMaxLength, ProjectNameMaxLength and MaxPageSize can only tighten
+	// the spec's bounds; Validate refuses values above them.
+	TitleMaxLength       int `yaml:"titleMaxLength"`
+	ProjectNameMaxLength int `yaml:"projectNameMaxLength"`
+	DefaultPageSize      int `yaml:"defaultPageSize"`
+	MaxPageSize          int `yaml:"maxPageSize"`
+	// BulkMaxItems caps how many tasks one bulk request may touch, counting
+	// every task an ID list or filter selects.
+	BulkMaxItems int `yaml:"bulkMaxItems"`
+	// BatchMaxRequests caps how many sub-requests one batch request may
+	// carry.
+	BatchMaxRequests int `yaml:"batchMaxRequests"`
+}
+
+type Tasks struct {
+	// EnforceBlockers refuses to move a task to IN_PROGRESS or DONE while a
+	// task blocking it is not DONE.
+	EnforceBlockers bool `yaml:"enforceBlockers"`
+}
+
+type Idempotency struct {
+	// TTL is how long an Idempotency-Key and the response it produced are
+	// kept for replay.
+	TTL time.Duration `yaml:"ttl"`
+	// CleanupInterval is how often expired keys are purged.
+	CleanupInterval time.Duration `yaml:"cleanupInterval"`
+}
+
+type Log struct {
+	Level string `yaml:"level"`
+}
+
+type Tracing struct {
+	// Exporter is one of "none", "stdout" or "otlp-file".
+	Exporter string `yaml:"exporter"`
+	// File is where the otlp-file exporter appends spans.
+	File string `yaml:"file"`
+	// SampleRatio is the fraction of new traces recorded, from 0 to 1. Requests
+	// carrying a traceparent follow the caller's sampled flag instead.
+	SampleRatio float64 `yaml:"sampleRatio"`
+}
+
+// Default returns the configuration used when nothing overrides it.
+func Default() Config {
+	return Config{
+		Server: Server{
+			Address:         "0.0.0.0:8080",
+			ShutdownTimeout: 15 * time.Second,
+		},
+		CORS: CORS{AllowedOrigins: []string{"http://localhost:5173"}},
+		DB: DB{
+			DSN:          "file:todo.db",
+			BusyTimeout:  5 * time.Second,
+			MaxReadConns: 4,
+		},
+		Limits: Limits{
+			TitleMaxLength:       200,
+			ProjectNameMaxLength: 128,
+			DefaultPageSize:      50,
+			MaxPageSize:          200,
+			BulkMaxItems:         500,
+			BatchMaxRequests:     20,
+		},
+		Idempotency: Idempotency{
+			TTL:             24 * time.Hour,
+			CleanupInterval: 10 * time.Minute,
+		},
+		Log: Log{Level: "info"},
+		Tracing: Tracing{
+			Exporter:    "none",
+			SampleRatio: 1,
+		},
+	}
+}
+
+// Load builds the configuration from, in increasing order of precedence, the
+// defaults, a YAML or JSON file, TODO_* environment variables and CLI flags.
+// The file is taken from -config or TODO_CONFIG.
+func Load(args []string, getenv func(string) string) (Config, error) {
+	cfg := Default()
+
+	fs := flag.NewFlagSet("full-stack-backend", flag.ContinueOnError)
+	var (
+		path    = fs.String("config", "", "path to a YAML or JSON config file")
+		address = fs.String("address", "", "listen address")
+		drain   = fs.Duration("shutdown-timeout", 0, "how long to drain in-flight requests on shutdown")
+		origins = fs.String("cors-origins", "", "comma-separated allowed CORS origins")
+		dsn     = fs.String("db-dsn", "", "database DSN (file path or in-memory DSN)")
+		busy    = fs.Duration("db-busy-timeout", 0, "SQLite busy timeout")
+		readers = fs.Int("db-max-read-conns", 0, "size of the read connection pool")
+		title   = fs.Int("title-max-length", 0, "maximum task title length")
+		name    = fs.Int("project-name-max-length", 0, "maximum project name length")
+		defSize = fs.Int("default-page-size", 0, "default page size for list endpoints")
+		maxSize = fs.Int("max-page-size", 0, "maximum page size for list endpoints")
+		bulkMax = fs.Int("bulk-max-items", 0, "maximum number of tasks one bulk request may touch")
+		batch   = fs.Int("batch-max-requests", 0, "maximum number of sub-requests one batch request may carry")
+		blocked = fs.Bool("enforce-blockers", false, "refuse to start or finish tasks whose blockers are not done")
+		keyTTL  = fs.Duration("idempotency-ttl", 0, "how long idempotency keys are kept")
+		purge   = fs.Duration("idempotency-cleanup-interval", 0, "how often expired idempotency keys are purged")
+		level   = fs.String("log-level", "", "log level (debug, info, warn, error)")
+		tracer  = fs.String("tracing-exporter", "", "span exporter (none, stdout, otlp-file)")
+		spans   = fs.String("tracing-file", "", "output file of the otlp-file exporter")
+		ratio   = fs.Float64("tracing-sample-ratio", 0, "fraction of new traces to record")
+	)
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	if *path == "" {
+		*path = getenv(EnvPrefix + "CONFIG")
+	}
+	if *path != "" {
+		if err := loadFile(*path, &cfg); err != nil {
+			return Config{}, err
+		}
+	}
+
+	if err := applyEnv(getenv, &cfg); err != nil {
+		return Config{}, err
+	}
+
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "address":
+			cfg.Server.Address = *address
+		case "shutdown-timeout":
+			cfg.Server.ShutdownTimeout = *drain
+		case "cors-origins":
+			cfg.CORS.AllowedOrigins = splitList(*origins)
+		case "db-dsn":
+			cfg.DB.DSN = *dsn
+		case "db-busy-timeout":
+			cfg.DB.BusyTimeout = *busy
+		case "db-max-read-conns":
+			cfg.DB.MaxReadConns = *readers
+		case "title-max-length":
+			cfg.Limits.TitleMaxLength = *title
+		case "project-name-max-length":
+			cfg.Limits.ProjectNameMaxLength = *name
+		case "default-page-size":
+			cfg.Limits.DefaultPageSize = *defSize
+		case "max-page-size":
+			cfg.Limits.MaxPageSize = *maxSize
+		case "bulk-max-items":
+			cfg.Limits.BulkMaxItems = *bulkMax
+		case "batch-max-requests":
+			cfg.Limits.BatchMaxRequests = *batch
+		case "enforce-blockers":
+			cfg.Tasks.EnforceBlockers = *blocked
+		case "idempotency-ttl":
+			cfg.Idempotency.TTL = *keyTTL
+		case "idempotency-cleanup-interval":
+			cfg.Idempotency.CleanupInterval = *purge
+		case "log-level":
+			cfg.Log.Level = *level
+		case "tracing-exporter":
+			cfg.Tracing.Exporter = *tracer
+		case "tracing-file":
+			cfg.Tracing.File = *spans
+		case "tracing-sample-ratio":
+			cfg.Tracing.SampleRatio = *ratio
+		}
+	})
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Validate reports every invalid setting at once.
+func (c Config) Validate() error {
+	var errs []error
+
+	if _, _, err := net.SplitHostPort(c.Server.Address); err != nil {
+		errs = append(errs, fmt.Errorf("server.address: %w", err))
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("server.shutdownTimeout: must be positive"))
+	}
+	for _, o := range c.CORS.AllowedOrigins {
+		if o == "*" {
+			continue
+		}
+		u, err := url.Parse(o)
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			errs = append(errs, fmt.Errorf("cors.allowedOrigins: %q is not an origin", o))
+		}
+	}
+	if strings.TrimSpace(c.DB.DSN) == "" {
+		errs = append(errs, errors.New("db.dsn: required"))
+	}
+	if c.DB.BusyTimeout < 0 {
+		errs = append(errs, errors.New("db.busyTimeout: must not be negative"))
+	}
+	if c.DB.MaxReadConns < 1 {
+		errs = append(errs, errors.New("db.maxReadConns: must be at least 1"))
+	}
+	if c.Limits.TitleMaxLength < 1 || c.Limits.TitleMaxLength > specTitleMaxLength {
+		errs = append(errs, fmt.Errorf("limits.titleMaxLength: must be between 1 and %d", specTitleMaxLength))
+	}
+	if c.Limits.ProjectNameMaxLength < 1 || c.Limits.ProjectNameMaxLength > specProjectNameMaxLength {
+		errs = append(errs, fmt.Errorf("limits.projectNameMaxLength: must be between 1 and %d", specProjectNameMaxLength))
+	}
+	if c.Limits.MaxPageSize < 1 || c.Limits.MaxPageSize > specMaxPageSize {
+		errs = append(errs, fmt.Errorf("limits.maxPageSize: must be between 1 and %d", specMaxPageSize))
+	}
+	if c.Limits.DefaultPageSize < 1 || c.Limits.DefaultPageSize > c.Limits.MaxPageSize {
+		errs = append(errs, errors.New("limits.defaultPageSize: must be between 1 and limits.maxPageSize"))
+	}
+	if c.Limits.BulkMaxItems < 1 {
+		errs = append(errs, errors.New("limits.bulkMaxItems: must be at least 1"))
+	}
+	if c.Limits.BatchMaxRequests < 1 {
+		errs = append(errs, errors.New("limits.batchMaxRequests: must be at least 1"))
+	}
+	if c.Idempotency.TTL <= 0 {
+		errs = append(errs, errors.New("idempotency.ttl: must be positive"))
+	}
+	if c.Idempotency.CleanupInterval <= 0 {
+		errs = append(errs, errors.New("idempotency.cleanupInterval: must be positive"))
+	}
+	if _, err := c.Log.SlogLevel(); err != nil {
+		errs = append(errs, fmt.Errorf("log.level: %w", err))
+	}
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp-file":
+		if strings.TrimSpace(c.Tracing.File) == "" {
+			errs = append(errs, errors.New("tracing.file: required by the otlp-file exporter"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter: %q is not one of none, stdout, otlp-file", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("tracing.sampleRatio: must be between 0 and 1"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// SlogLevel parses the configured log level.
+func (l Log) SlogLevel() (slog.Level, error) {
+	var lvl slog.Level
+	err := lvl.UnmarshalText([]byte(l.Level))
+	return lvl, err
+}
+
+// loadFile decodes a YAML file over cfg. JSON is valid YAML, so .json files
+// go through the same decoder.
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return nil
+}
+
+func applyEnv(getenv func(string) string, cfg *Config) error {
+	var errs []error
+
+	str := func(key string, dst *string) {
+		if v := getenv(EnvPrefix + key); v != "" {
+			*dst = v
+		}
+	}
+	num := func(key string, dst *int) {
+		if v := getenv(EnvPrefix + key); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s%s: %w", EnvPrefix, key, err))
+				return
+			}
+			*dst = n
+		}
+	}
+	float := func(key string, dst *float64) {
+		if v := getenv(EnvPrefix + key); v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s%s: %w", EnvPrefix, key, err))
+				return
+			}
+			*dst = f
+		}
+	}
+	boolean := func(key string, dst *bool) {
+		if v := getenv(EnvPrefix + key); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s%s: %w", EnvPrefix, key, err))
+				return
+			}
+			*dst = b
+		}
+	}
+	dur := func(key string, dst *time.Duration) {
+		if v := getenv(EnvPrefix + key); v != "" {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s%s: %w", EnvPrefix, key, err))
+				return
+			}
+			*dst = d
+		}
+	}
+
+	str("SERVER_ADDRESS", &cfg.Server.Address)
+	dur("SERVER_SHUTDOWN_TIMEOUT", &cfg.Server.ShutdownTimeout)
+	if v := getenv(EnvPrefix + "CORS_ALLOWED_ORIGINS"); v != "" {
+		cfg.CORS.AllowedOrigins = splitList(v)
+	}
+	str("DB_DSN", &cfg.DB.DSN)
+	dur("DB_BUSY_TIMEOUT", &cfg.DB.BusyTimeout)
+	num("DB_MAX_READ_CONNS", &cfg.DB.MaxReadConns)
+	num("LIMITS_TITLE_MAX_LENGTH", &cfg.Limits.TitleMaxLength)
+	num("LIMITS_PROJECT_NAME_MAX_LENGTH", &cfg.Limits.ProjectNameMaxLength)
+	num("LIMITS_DEFAULT_PAGE_SIZE", &cfg.Limits.DefaultPageSize)
+	num("LIMITS_MAX_PAGE_SIZE", &cfg.Limits.MaxPageSize)
+	num("LIMITS_BULK_MAX_ITEMS", &cfg.Limits.BulkMaxItems)
+	num("LIMITS_BATCH_MAX_REQUESTS", &cfg.Limits.BatchMaxRequests)
+	boolean("TASKS_ENFORCE_BLOCKERS", &cfg.Tasks.EnforceBlockers)
+	dur("IDEMPOTENCY_TTL", &cfg.Idempotency.TTL)
+	dur("IDEMPOTENCY_CLEANUP_INTERVAL", &cfg.Idempotency.CleanupInterval)
+	str("LOG_LEVEL", &cfg.Log.Level)
+	str("TRACING_EXPORTER", &cfg.Tracing.Exporter)
+	str("TRACING_FILE", &cfg.Tracing.File)
+	float("TRACING_SAMPLE_RATIO", &cfg.Tracing.SampleRatio)
+
+	return errors.Join(errs...)
+}
+
+func splitList(s string) []string {
+	parts := strings.Split(s, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
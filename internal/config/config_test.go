@@ -0,0 +1,185 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"full-stack-assesment/internal/config"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Load", func() {
+	var env map[string]string
+
+	getenv := func(k string) string { return env[k] }
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), name)
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		env = map[string]string{}
+	})
+
+	It("returns the defaults when nothing is set", func() {
+		cfg, err := config.Load(nil, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg).To(Equal(config.Default()))
+	})
+
+	It("reads a YAML file", func() {
+		path := writeFile("config.yaml", `
+server:
+  address: 127.0.0.1:9000
+db:
+  dsn: /data/todo.db
+  busyTimeout: 2s
+limits:
+  defaultPageSize: 20
+`)
+		cfg, err := config.Load([]string{"-config", path}, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Server.Address).To(Equal("127.0.0.1:9000"))
+		Expect(cfg.DB.DSN).To(Equal("/data/todo.db"))
+		Expect(cfg.DB.BusyTimeout).To(Equal(2 * time.Second))
+		Expect(cfg.Limits.DefaultPageSize).To(Equal(20))
+		Expect(cfg.Limits.MaxPageSize).To(Equal(200))
+	})
+
+	It("reads a JSON file named by the environment", func() {
+		env["TODO_CONFIG"] = writeFile("config.json", `{"cors": {"allowedOrigins": ["https://app.example.com"]}}`)
+		cfg, err := config.Load(nil, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.CORS.AllowedOrigins).To(Equal([]string{"https://app.example.com"}))
+	})
+
+	It("rejects unknown keys in the file", func() {
+		path := writeFile("config.yaml", "server:\n  port: 8080\n")
+		_, err := config.Load([]string{"-config", path}, getenv)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("lets env override the file and flags override env", func() {
+		path := writeFile("config.yaml", "server:\n  address: 127.0.0.1:9000\nlog:\n  level: debug\n")
+		env["TODO_SERVER_ADDRESS"] = "127.0.0.1:9001"
+		env["TODO_LOG_LEVEL"] = "warn"
+		env["TODO_CORS_ALLOWED_ORIGINS"] = "https://a.example.com, https://b.example.com"
+
+		cfg, err := config.Load([]string{"-config", path, "-address", "127.0.0.1:9002"}, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Server.Address).To(Equal("127.0.0.1:9002"))
+		Expect(cfg.Log.Level).To(Equal("warn"))
+		Expect(cfg.CORS.AllowedOrigins).To(Equal([]string{"https://a.example.com", "https://b.example.com"}))
+	})
+
+	It("reports malformed environment values", func() {
+		env["TODO_LIMITS_MAX_PAGE_SIZE"] = "lots"
+		_, err := config.Load(nil, getenv)
+		Expect(err).To(MatchError(ContainSubstring("TODO_LIMITS_MAX_PAGE_SIZE")))
+	})
+
+	It("reads tracing settings", func() {
+		env["TODO_TRACING_SAMPLE_RATIO"] = "0.25"
+		cfg, err := config.Load([]string{"-tracing-exporter", "otlp-file", "-tracing-file", "/tmp/spans.json"}, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Tracing).To(Equal(config.Tracing{Exporter: "otlp-file", File: "/tmp/spans.json", SampleRatio: 0.25}))
+
+		_, err = config.Load([]string{"-tracing-exporter", "otlp-file", "-tracing-sample-ratio", "2"}, getenv)
+		Expect(err).To(MatchError(SatisfyAll(
+			ContainSubstring("tracing.file"),
+			ContainSubstring("tracing.sampleRatio"),
+		)))
+	})
+
+	It("reads idempotency settings", func() {
+		env["TODO_IDEMPOTENCY_TTL"] = "1h"
+		cfg, err := config.Load([]string{"-idempotency-cleanup-interval", "30s"}, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Idempotency).To(Equal(config.Idempotency{TTL: time.Hour, CleanupInterval: 30 * time.Second}))
+
+		_, err = config.Load([]string{"-idempotency-ttl", "0s"}, getenv)
+		Expect(err).To(MatchError(ContainSubstring("idempotency.ttl")))
+	})
+
+	It("reads the bulk item limit", func() {
+		env["TODO_LIMITS_BULK_MAX_ITEMS"] = "20"
+		cfg, err := config.Load(nil, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Limits.BulkMaxItems).To(Equal(20))
+
+		_, err = config.Load([]string{"-bulk-max-items", "0"}, getenv)
+		Expect(err).To(MatchError(ContainSubstring("limits.bulkMaxItems")))
+	})
+
+	It("reads the batch request limit", func() {
+		env["TODO_LIMITS_BATCH_MAX_REQUESTS"] = "5"
+		cfg, err := config.Load(nil, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Limits.BatchMaxRequests).To(Equal(5))
+
+		_, err = config.Load([]string{"-batch-max-requests", "0"}, getenv)
+		Expect(err).To(MatchError(ContainSubstring("limits.batchMaxRequests")))
+	})
+
+	It("reads whether blockers are enforced", func() {
+		cfg, err := config.Load(nil, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Tasks.EnforceBlockers).To(BeFalse())
+
+		env["TODO_TASKS_ENFORCE_BLOCKERS"] = "true"
+		cfg, err = config.Load(nil, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Tasks.EnforceBlockers).To(BeTrue())
+
+		cfg, err = config.Load([]string{"-enforce-blockers=false"}, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Tasks.EnforceBlockers).To(BeFalse())
+
+		env["TODO_TASKS_ENFORCE_BLOCKERS"] = "maybe"
+		_, err = config.Load(nil, getenv)
+		Expect(err).To(MatchError(ContainSubstring("TODO_TASKS_ENFORCE_BLOCKERS")))
+	})
+
+	It("validates the merged configuration", func() {
+		_, err := config.Load([]string{
+			"-address", "nope",
+			"-cors-origins", "localhost",
+			"-default-page-size", "500",
+			"-log-level", "loud",
+		}, getenv)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(SatisfyAll(
+			ContainSubstring("server.address"),
+			ContainSubstring("cors.allowedOrigins"),
+			ContainSubstring("limits.defaultPageSize"),
+			ContainSubstring("log.level"),
+		))
+	})
+
+	It("refuses limits looser than the spec's", func() {
+		_, err := config.Load([]string{
+			"-title-max-length", "201",
+			"-project-name-max-length", "129",
+			"-max-page-size", "201",
+		}, getenv)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(SatisfyAll(
+			ContainSubstring("limits.titleMaxLength: must be between 1 and 200"),
+			ContainSubstring("limits.projectNameMaxLength: must be between 1 and 128"),
+			ContainSubstring("limits.maxPageSize: must be between 1 and 200"),
+		))
+
+		cfg, err := config.Load([]string{
+			"-title-max-length", "200",
+			"-project-name-max-length", "128",
+			"-max-page-size", "200",
+		}, getenv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Limits.MaxPageSize).To(Equal(200))
+	})
+})
@@ -0,0 +1,108 @@
+// Package docs serves the API's own OpenAPI document and a self-contained
+// explorer page, so the contract can be read and tried against a running
+// server instead of a checkout of the repository.
+package docs
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed explorer.html
+var explorerPage []byte
+
+// Docs serves one OpenAPI document.
+type Docs struct {
+	spec *openapi3.T
+}
+
+// New serves spec, normally the one embedded in the generated server.
+func New(spec *openapi3.T) *Docs {
+	return &Docs{spec: spec}
+}
+
+// JSON serves the document as JSON.
+func (d *Docs) JSON() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := json.MarshalIndent(d.forRequest(r), "", "  ")
+		if err != nil {
+			d.fail(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-cache")
+		_, _ = w.Write(b)
+	})
+}
+
+// YAML serves the document as YAML.
+func (d *Docs) YAML() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var b bytes.Buffer
+		enc := yaml.NewEncoder(&b)
+		enc.SetIndent(2)
+		if err := enc.Encode(d.forRequest(r)); err != nil {
+			d.fail(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Header().Set("Cache-Control", "no-cache")
+		_, _ = w.Write(b.Bytes())
+	})
+}
+
+// Explorer serves the API explorer page. It loads openapi.json from the same
+// directory and needs no network access beyond the server itself.
+func (d *Docs) Explorer() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Security-Policy", "default-src 'self'; script-src 'unsafe-inline'; style-src 'unsafe-inline'; connect-src *")
+		_, _ = w.Write(explorerPage)
+	})
+}
+
+// forRequest returns a copy of the document whose only server is the one the
+// request reached, so "try it" calls and generated clients target the
+// environment the document was fetched from.
+func (d *Docs) forRequest(r *http.Request) *openapi3.T {
+	doc := *d.spec
+	doc.Servers = openapi3.Servers{{URL: BaseURL(r)}}
+	return &doc
+}
+
+func (d *Docs) fail(w http.ResponseWriter, r *http.Request, err error) {
+	slog.ErrorContext(r.Context(), "render openapi document", slog.Any("error", err))
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// BaseURL is the scheme and host the client used to reach the server. Behind
+// a proxy it follows the X-Forwarded-Proto and X-Forwarded-Host headers.
+func BaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if p := firstValue(r.Header.Get("X-Forwarded-Proto")); p == "http" || p == "https" {
+		scheme = p
+	}
+
+	host := r.Host
+	if h := firstValue(r.Header.Get("X-Forwarded-Host")); h != "" {
+		host = h
+	}
+	return scheme + "://" + host
+}
+
+// firstValue returns the first entry of a comma-separated header, the one
+// added by the proxy closest to the client.
+func firstValue(v string) string {
+	first, _, _ := strings.Cut(v, ",")
+	return strings.TrimSpace(first)
+}
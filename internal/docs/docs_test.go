@@ -0,0 +1,87 @@
+package docs_test
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+
+	"full-stack-assesment/internal/api"
+	"full-stack-assesment/internal/docs"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Docs", func() {
+	var d *docs.Docs
+
+	BeforeEach(func() {
+		spec, err := api.GetSwagger()
+		Expect(err).NotTo(HaveOccurred())
+		d = docs.New(spec)
+	})
+
+	serve := func(h http.Handler, req *http.Request) *httptest.ResponseRecorder {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, req)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		return rr
+	}
+
+	load := func(rr *httptest.ResponseRecorder) *openapi3.T {
+		doc, err := openapi3.NewLoader().LoadFromData(rr.Body.Bytes())
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, doc.Validate(openapi3.NewLoader().Context)).To(Succeed())
+		return doc
+	}
+
+	It("serves the embedded document as JSON with the request's host as server", func() {
+		req := httptest.NewRequest(http.MethodGet, "http://todo.internal:8080/openapi.json", nil)
+		rr := serve(d.JSON(), req)
+
+		Expect(rr.Header().Get("Content-Type")).To(Equal("application/json"))
+		doc := load(rr)
+		Expect(doc.Servers).To(HaveLen(1))
+		Expect(doc.Servers[0].URL).To(Equal("http://todo.internal:8080"))
+		Expect(doc.Paths.Find("/projects/{projectId}/tasks")).NotTo(BeNil())
+	})
+
+	It("serves the same document as YAML", func() {
+		req := httptest.NewRequest(http.MethodGet, "http://todo.internal/openapi.yaml", nil)
+		rr := serve(d.YAML(), req)
+
+		Expect(rr.Header().Get("Content-Type")).To(Equal("application/yaml"))
+		doc := load(rr)
+		Expect(doc.Servers[0].URL).To(Equal("http://todo.internal"))
+		Expect(doc.Paths.Find("/projects")).NotTo(BeNil())
+	})
+
+	It("follows the scheme and host a proxy forwarded", func() {
+		req := httptest.NewRequest(http.MethodGet, "http://10.0.0.7:8080/openapi.json", nil)
+		req.Header.Set("X-Forwarded-Proto", "https")
+		req.Header.Set("X-Forwarded-Host", "todo.example.com, edge.internal")
+		Expect(load(serve(d.JSON(), req)).Servers[0].URL).To(Equal("https://todo.example.com"))
+	})
+
+	It("uses https for TLS connections", func() {
+		req := httptest.NewRequest(http.MethodGet, "https://todo.example.com/openapi.json", nil)
+		req.TLS = &tls.ConnectionState{}
+		Expect(docs.BaseURL(req)).To(Equal("https://todo.example.com"))
+	})
+
+	It("does not leak one request's server into the next", func() {
+		first := httptest.NewRequest(http.MethodGet, "http://a.example/openapi.json", nil)
+		second := httptest.NewRequest(http.MethodGet, "http://b.example/openapi.json", nil)
+		Expect(load(serve(d.JSON(), first)).Servers[0].URL).To(Equal("http://a.example"))
+		Expect(load(serve(d.JSON(), second)).Servers).To(HaveLen(1))
+	})
+
+	It("serves a self-contained explorer that loads the JSON document", func() {
+		rr := serve(d.Explorer(), httptest.NewRequest(http.MethodGet, "/docs", nil))
+
+		Expect(rr.Header().Get("Content-Type")).To(HavePrefix("text/html"))
+		Expect(rr.Body.String()).To(ContainSubstring(`fetch("openapi.json")`))
+		Expect(rr.Body.String()).NotTo(MatchRegexp(`(src|href)="https?://`))
+	})
+})
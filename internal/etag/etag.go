@@ -0,0 +1,87 @@
+// Package etag turns row versions into entity tags and evaluates the
+// If-Match and If-None-Match preconditions against them (RFC 9110, 13.1).
+package etag
+
+import (
+	"strconv"
+	"strings"
+
+	"full-stack-assesment/internal/apierrors"
+)
+
+// For returns the strong entity tag of a resource at version.
+func For(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// Matches reports whether the If-Match style header lists tag, or is "*",
+// using the strong comparison: weak tags never match.
+func Matches(header, tag string) bool {
+	return match(header, tag, false)
+}
+
+// MatchesWeak reports whether the If-None-Match style header lists tag, or
+// is "*", using the weak comparison that ignores the W/ prefix.
+func MatchesWeak(header, tag string) bool {
+	return match(header, tag, true)
+}
+
+// Check evaluates an If-Match header against the current version of a
+// resource. It returns ErrPreconditionFailed when the header does not match,
+// and otherwise the version a conditional write must still find, or 0 when
+// there was no header and the write need not be conditional.
+func Check(ifMatch *string, version int) (int, error) {
+	if ifMatch == nil {
+		return 0, nil
+	}
+	if !Matches(*ifMatch, For(version)) {
+		return 0, apierrors.ErrPreconditionFailed
+	}
+	return version, nil
+}
+
+func match(header, tag string, weak bool) bool {
+	header = strings.TrimSpace(header)
+	if header == "*" {
+		return true
+	}
+	for _, t := range list(header) {
+		isWeak := strings.HasPrefix(t, "W/")
+		if isWeak {
+			if !weak {
+				continue
+			}
+			t = t[len("W/"):]
+		}
+		if t == strings.TrimPrefix(tag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// list splits a comma separated list of entity tags, keeping the quotes and
+// any W/ prefix. Parsing stops at the first malformed tag.
+func list(header string) []string {
+	var out []string
+	for {
+		header = strings.TrimLeft(header, " \t,")
+		if header == "" {
+			return out
+		}
+		start := 0
+		if strings.HasPrefix(header, "W/") {
+			start = len("W/")
+		}
+		if len(header) <= start || header[start] != '"' {
+			return out
+		}
+		end := strings.IndexByte(header[start+1:], '"')
+		if end < 0 {
+			return out
+		}
+		end += start + 2
+		out = append(out, header[:end])
+		header = header[end:]
+	}
+}
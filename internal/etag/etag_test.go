@@ -0,0 +1,47 @@
+package etag_test
+
+import (
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/etag"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ETag", func() {
+	It("quotes the version", func() {
+		Expect(etag.For(7)).To(Equal(`"7"`))
+	})
+
+	DescribeTable("compares tags",
+		func(header string, strong, weak bool) {
+			Expect(etag.Matches(header, `"7"`)).To(Equal(strong))
+			Expect(etag.MatchesWeak(header, `"7"`)).To(Equal(weak))
+		},
+		Entry("same tag", `"7"`, true, true),
+		Entry("any", ` * `, true, true),
+		Entry("other tag", `"6"`, false, false),
+		Entry("weak tag", `W/"7"`, false, true),
+		Entry("in a list", `"5", W/"6" ,"7"`, true, true),
+		Entry("tag with a comma", `"5,7", "8"`, false, false),
+		Entry("unquoted", `7`, false, false),
+		Entry("malformed after a match", `"7", "8`, true, true),
+		Entry("malformed before a match", `8", "7"`, false, false),
+		Entry("empty", ``, false, false),
+	)
+
+	It("checks If-Match against the current version", func() {
+		v, err := etag.Check(nil, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(Equal(0))
+
+		header := `"2", "3"`
+		v, err = etag.Check(&header, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(Equal(3))
+
+		header = `"2"`
+		_, err = etag.Check(&header, 3)
+		Expect(err).To(MatchError(apierrors.ErrPreconditionFailed))
+	})
+})
@@ -0,0 +1,195 @@
+// Package filter parses the filter expressions clients send to narrow a
+// listing and compiles them to parameterised SQL. A filter reads like
+//
+//	status in (TODO, IN_PROGRESS) and title ~ "deploy" and updatedAt > -7d
+//
+// Comparisons are joined with and, or and not, and grouped with parentheses.
+// Fields and the columns they stand for come from a whitelist supplied by the
+// caller; every value in the expression is passed as a query argument, so no
+// text the client sends is ever spliced into SQL.
+package filter
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"full-stack-assesment/internal/helpers"
+)
+
+// Limits on the size of an expression, so a filter cannot make the parser
+// recurse without bound or the statement take more arguments than SQLite
+// allows.
+const (
+	MaxLength = 1000
+	MaxDepth  = 20
+	MaxValues = 100
+)
+
+// Error is a syntax or type error in a filter expression.
+type Error struct {
+	// Pos is the 1-based column of the offending token.
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return e.Msg + " at column " + strconv.Itoa(e.Pos)
+}
+
+func errorf(pos int, format string, args ...any) *Error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Kind is the type of a field. It decides which operators and values the
+// field accepts.
+type Kind int
+
+const (
+	// Text fields take =, !=, ~ (contains, ignoring case), !~, in and
+	// not in, with quoted or bare words as values.
+	Text Kind = iota
+	// Enum fields take =, !=, in and not in, with one of Field.Values.
+	Enum
+	// Time fields take <, <=, > and >=. Values are RFC 3339 times, dates,
+	// now, or offsets from now such as -7d, -12h or +1w.
+	Time
+)
+
+// Field describes one field a filter may name.
+type Field struct {
+	// Column is the SQL column the field compares. It is written into the
+	// statement as is and must never come from the client.
+	Column string
+	Kind   Kind
+	// Values are the allowed values of an Enum field. Values given in a
+	// filter are matched ignoring case and replaced by the listed spelling.
+	Values []string
+}
+
+// Fields maps the names a filter may use to their fields. Names are matched
+// ignoring case.
+type Fields map[string]Field
+
+func (fs Fields) lookup(name string) (Field, bool) {
+	for n, f := range fs {
+		if strings.EqualFold(n, name) {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+func (fs Fields) names() string {
+	names := make([]string, 0, len(fs))
+	for n := range fs {
+		names = append(names, n)
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+// Filter is a parsed filter expression.
+type Filter struct {
+	root node
+}
+
+// Parse parses src against fields. Errors are *Error values pointing at the
+// offending part of src.
+func Parse(src string, fields Fields) (*Filter, error) {
+	if len(src) > MaxLength {
+		return nil, errorf(MaxLength+1, "filter is longer than %d characters", MaxLength)
+	}
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks, fields: fields}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, errorf(t.pos, "unexpected %s, expected and, or or the end of the filter", t.describe())
+	}
+	return &Filter{root: root}, nil
+}
+
+// SQL renders f as a WHERE condition and its arguments. Offsets such as -7d
+// are resolved against now.
+func (f *Filter) SQL(now time.Time) (string, []any) {
+	var b sqlBuilder
+	b.now = now
+	b.write(f.root)
+	return b.sql.String(), b.args
+}
+
+type sqlBuilder struct {
+	sql  strings.Builder
+	args []any
+	now  time.Time
+}
+
+func (b *sqlBuilder) write(n node) {
+	switch n := n.(type) {
+	case *logical:
+		b.sql.WriteString("(")
+		b.write(n.left)
+		b.sql.WriteString(" " + n.op + " ")
+		b.write(n.right)
+		b.sql.WriteString(")")
+	case *negation:
+		b.sql.WriteString("NOT (")
+		b.write(n.x)
+		b.sql.WriteString(")")
+	case *comparison:
+		b.writeComparison(n)
+	}
+}
+
+func (b *sqlBuilder) writeComparison(c *comparison) {
+	col := c.field.Column
+	switch c.op {
+	case "in", "not in":
+		marks := strings.TrimSuffix(strings.Repeat("?, ", len(c.values)), ", ")
+		b.sql.WriteString(col + " " + strings.ToUpper(c.op) + " (" + marks + ")")
+		for _, v := range c.values {
+			b.args = append(b.args, v.text)
+		}
+		return
+	case "~":
+		b.sql.WriteString(col + ` LIKE ? ESCAPE '\'`)
+		b.args = append(b.args, helpers.ContainsPattern(c.values[0].text))
+		return
+	case "!~":
+		b.sql.WriteString(col + ` NOT LIKE ? ESCAPE '\'`)
+		b.args = append(b.args, helpers.ContainsPattern(c.values[0].text))
+		return
+	}
+
+	b.sql.WriteString(col + " " + sqlOperators[c.op] + " ?")
+	v := c.values[0]
+	if c.field.Kind == Time {
+		t := v.time
+		if v.relative {
+			t = b.now.Add(v.offset)
+		}
+		b.args = append(b.args, helpers.FormatTime(t))
+		return
+	}
+	b.args = append(b.args, v.text)
+}
+
+// sqlOperators maps the filter's comparison operators onto SQL's.
+var sqlOperators = map[string]string{
+	"=":  "=",
+	"!=": "<>",
+	"<":  "<",
+	"<=": "<=",
+	">":  ">",
+	">=": ">=",
+}
+
+func quote(s string) string { return strconv.Quote(s) }
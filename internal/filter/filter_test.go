@@ -0,0 +1,113 @@
+package filter_test
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"full-stack-assesment/internal/filter"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var fields = filter.Fields{
+	"status":      {Column: "status", Kind: filter.Enum, Values: []string{"TODO", "IN_PROGRESS", "DONE"}},
+	"title":       {Column: "title", Kind: filter.Text},
+	"description": {Column: "description", Kind: filter.Text},
+	"createdAt":   {Column: "created_at", Kind: filter.Time},
+	"updatedAt":   {Column: "updated_at", Kind: filter.Time},
+}
+
+var now = time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+func compile(src string) (string, []any) {
+	f, err := filter.Parse(src, fields)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), src)
+	return f.SQL(now)
+}
+
+// parseError returns the error Parse reports for src.
+func parseError(src string) *filter.Error {
+	_, err := filter.Parse(src, fields)
+	var fe *filter.Error
+	ExpectWithOffset(1, errors.As(err, &fe)).To(BeTrue(), "%s: %v", src, err)
+	return fe
+}
+
+var _ = Describe("Parse", func() {
+	It("compiles the example from the docs", func() {
+		sql, args := compile(`status in (TODO, IN_PROGRESS) and title ~ "deploy" and updatedAt > -7d`)
+		Expect(sql).To(Equal(`((status IN (?, ?) AND title LIKE ? ESCAPE '\') AND updated_at > ?)`))
+		Expect(args).To(Equal([]any{"TODO", "IN_PROGRESS", "%deploy%", "2025-03-03T12:00:00.000000000Z"}))
+	})
+
+	It("binds and tighter than or, and honours parentheses and not", func() {
+		sql, _ := compile(`status = DONE or title = a and title = b`)
+		Expect(sql).To(Equal(`(status = ? OR (title = ? AND title = ?))`))
+
+		sql, _ = compile(`(status = DONE or title = a) and not title = b`)
+		Expect(sql).To(Equal(`((status = ? OR title = ?) AND NOT (title = ?))`))
+	})
+
+	It("normalises operators, keywords and enum values", func() {
+		sql, args := compile(`STATUS NOT IN (done) AND title <> 'x' OR title == "y" and description !~ z`)
+		Expect(sql).To(Equal(`((status NOT IN (?) AND title <> ?) OR (title = ? AND description NOT LIKE ? ESCAPE '\'))`))
+		Expect(args).To(Equal([]any{"DONE", "x", "y", "%z%"}))
+
+		sql, args = compile(`status != in_progress`)
+		Expect(sql).To(Equal(`status <> ?`))
+		Expect(args).To(Equal([]any{"IN_PROGRESS"}))
+	})
+
+	It("reads absolute, relative and current times", func() {
+		_, args := compile(`createdAt >= 2025-01-31 and createdAt < "2025-02-01T08:30:00+02:00" and updatedAt <= now and updatedAt > +1w`)
+		Expect(args).To(Equal([]any{
+			"2025-01-31T00:00:00.000000000Z",
+			"2025-02-01T06:30:00.000000000Z",
+			"2025-03-10T12:00:00.000000000Z",
+			"2025-03-17T12:00:00.000000000Z",
+		}))
+	})
+
+	It("takes LIKE wildcards and quotes in values literally", func() {
+		sql, args := compile(`title ~ "100%_\"done\"" and description = 'it\'s'`)
+		Expect(sql).To(Equal(`(title LIKE ? ESCAPE '\' AND description = ?)`))
+		Expect(args).To(Equal([]any{`%100\%\_"done"%`, "it's"}))
+	})
+
+	DescribeTable("reports errors with their column",
+		func(src string, pos int, msg string) {
+			err := parseError(src)
+			Expect(err.Pos).To(Equal(pos))
+			Expect(err.Msg).To(ContainSubstring(msg))
+		},
+		Entry("empty filter", "", 1, "expected a field name"),
+		Entry("unknown field", "priority = 1", 1, `unknown field "priority"; use createdAt, description, status, title, updatedAt`),
+		Entry("missing operator", "title deploy", 7, "expected an operator after title"),
+		Entry("operator for another kind", "status ~ DONE", 8, `operator "~" does not apply to status`),
+		Entry("time equality", "updatedAt = now", 11, `operator "=" does not apply to updatedAt`),
+		Entry("invalid enum value", "status = BLOCKED", 10, `invalid value "BLOCKED"; use TODO, IN_PROGRESS, DONE`),
+		Entry("invalid time", "createdAt > yesterday", 13, `invalid time "yesterday"`),
+		Entry("missing value", "title =", 8, "unexpected end of filter, expected a value"),
+		Entry("unterminated string", `title = "deploy`, 9, "unterminated string"),
+		Entry("unexpected character", "title = a; DROP TABLE tasks", 10, `unexpected character ";"`),
+		Entry("unclosed parenthesis", "(title = a", 11, `expected ")" to close the "(" at column 1`),
+		Entry("trailing tokens", "title = a title = b", 11, `unexpected "title", expected and, or or the end of the filter`),
+		Entry("not without in", "status not DONE", 12, "expected in after not"),
+		Entry("list without parenthesis", "status in TODO", 11, `expected "(" to start the list of values`),
+		Entry("unterminated list", "status in (TODO DONE)", 17, `expected "," or ")"`),
+		Entry("dangling and", "title = a and", 14, "expected a field name"),
+	)
+
+	It("limits length, nesting and list size", func() {
+		Expect(parseError(`title = "` + strings.Repeat("a", filter.MaxLength) + `"`).Msg).To(ContainSubstring("longer than"))
+		Expect(parseError(strings.Repeat("(", filter.MaxDepth+1) + "title = a" + strings.Repeat(")", filter.MaxDepth+1)).Msg).
+			To(ContainSubstring("nested more than"))
+		Expect(parseError(strings.Repeat("not ", filter.MaxDepth+1) + "title = a").Msg).To(ContainSubstring("nested more than"))
+		Expect(parseError("title in (" + strings.Repeat("a, ", filter.MaxValues) + "a)").Msg).To(ContainSubstring("at most"))
+
+		_, err := filter.Parse(strings.Repeat("(", filter.MaxDepth-1)+"title = a"+strings.Repeat(")", filter.MaxDepth-1), fields)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
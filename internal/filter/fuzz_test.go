@@ -0,0 +1,74 @@
+package filter_test
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"full-stack-assesment/internal/filter"
+	"full-stack-assesment/internal/migrate"
+	"full-stack-assesment/internal/store"
+)
+
+// sqlVocabulary matches SQL made only of the fixed fragments the compiler
+// writes and the whitelisted columns. Anything taken from the filter text
+// would have to appear outside it.
+var sqlVocabulary = regexp.MustCompile(`^(?:[ (),?]|AND|OR|NOT|IN|LIKE|ESCAPE|'\\'|<>|<=|>=|=|<|>|status|title|description|created_at|updated_at)*$`)
+
+// FuzzParse checks that whatever the input, Parse does not panic and every
+// filter it accepts compiles to SQL that carries no client text, binds one
+// argument per placeholder, and runs against the real tasks table without
+// touching anything else.
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{
+		`status in (TODO, IN_PROGRESS) and title ~ "deploy" and updatedAt > -7d`,
+		`not (status = DONE or description !~ 'x') and createdAt <= 2025-01-31`,
+		`title = "'; DROP TABLE tasks; --"`,
+		`title = a) OR 1=1 --`,
+		`title ~ "%" or title = '\'' or title = "\"; SELECT 1"`,
+		`status = DONE/**/OR/**/1=1`,
+		`updatedAt > "2025-02-01T08:30:00+02:00" and createdAt < now`,
+		`title in (a, "b", 'c') and status not in (todo)`,
+		`((((title = x))))`,
+		`createdAt > -9999999999999999999d`,
+	} {
+		f.Add(seed)
+	}
+
+	ctx := context.Background()
+	db, err := store.InMemory(ctx)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Cleanup(func() { _ = db.Close() })
+	if err := migrate.Apply(ctx, db.DB); err != nil {
+		f.Fatal(err)
+	}
+
+	f.Fuzz(func(t *testing.T, src string) {
+		parsed, err := filter.Parse(src, fields)
+		if err != nil {
+			if _, ok := err.(*filter.Error); !ok {
+				t.Fatalf("Parse(%q) returned %T, want *filter.Error", src, err)
+			}
+			return
+		}
+
+		sql, args := parsed.SQL(now)
+		if !sqlVocabulary.MatchString(sql) {
+			t.Fatalf("Parse(%q) compiled to SQL outside the fixed vocabulary: %s", src, sql)
+		}
+		if n := strings.Count(sql, "?"); n != len(args) {
+			t.Fatalf("Parse(%q) compiled to %d placeholders but %d arguments: %s", src, n, len(args), sql)
+		}
+
+		var count int
+		if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM tasks WHERE `+sql, args...).Scan(&count); err != nil {
+			t.Fatalf("Parse(%q) compiled to SQL that does not run: %s: %v", src, sql, err)
+		}
+		if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM projects`).Scan(&count); err != nil {
+			t.Fatalf("Parse(%q) damaged the schema: %v", src, err)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+package filter
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	// tokWord is a bare word: a field name, a keyword or an unquoted value
+	// such as TODO, -7d or 2024-05-01.
+	tokWord
+	tokString
+	tokOp
+	tokLParen
+	tokRParen
+	tokComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	// pos is the 1-based column of the token's first character.
+	pos int
+}
+
+func (t token) describe() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of filter"
+	case tokString:
+		return "string " + quote(t.text)
+	}
+	return quote(t.text)
+}
+
+// operators lists the comparison operators, longest first so that "<=" is
+// not read as "<" followed by "=".
+var operators = []string{"!=", "<>", "<=", ">=", "==", "!~", "=", "<", ">", "~"}
+
+// lex splits src into tokens, ending with tokEOF.
+func lex(src string) ([]token, error) {
+	var (
+		out []token
+		rs  = []rune(src)
+	)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		pos := i + 1
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			out = append(out, token{tokLParen, "(", pos})
+			i++
+		case r == ')':
+			out = append(out, token{tokRParen, ")", pos})
+			i++
+		case r == ',':
+			out = append(out, token{tokComma, ",", pos})
+			i++
+		case r == '"' || r == '\'':
+			s, n, err := lexString(rs[i:], pos)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, token{tokString, s, pos})
+			i += n
+		case isWordStart(r):
+			n := 1
+			for i+n < len(rs) && isWordRune(rs[i+n]) {
+				n++
+			}
+			out = append(out, token{tokWord, string(rs[i : i+n]), pos})
+			i += n
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(string(rs[i:min(i+2, len(rs))]), o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, errorf(pos, "unexpected character %s", quote(string(r)))
+			}
+			out = append(out, token{tokOp, op, pos})
+			i += len(op)
+		}
+	}
+	return append(out, token{tokEOF, "", len(rs) + 1}), nil
+}
+
+// lexString reads the quoted string at the start of rs. A backslash makes
+// the next character literal. It returns the string and the runes read.
+func lexString(rs []rune, pos int) (string, int, error) {
+	quoteRune := rs[0]
+	var b strings.Builder
+	for i := 1; i < len(rs); i++ {
+		switch rs[i] {
+		case '\\':
+			if i+1 == len(rs) {
+				return "", 0, errorf(pos, "unterminated string")
+			}
+			i++
+			b.WriteRune(rs[i])
+		case quoteRune:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteRune(rs[i])
+		}
+	}
+	return "", 0, errorf(pos, "unterminated string")
+}
+
+// Words start with a letter, a digit or a sign, so that offsets such as -7d
+// and dates such as 2024-05-01 need no quotes.
+func isWordStart(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '+'
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '+' || r == ':' || r == '.'
+}
@@ -0,0 +1,267 @@
+package filter
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The grammar, lowest precedence first:
+//
+//	or         = and { "or" and }
+//	and        = unary { "and" unary }
+//	unary      = "not" unary | "(" or ")" | comparison
+//	comparison = field op value | field ["not"] "in" "(" value { "," value } ")"
+//
+// Keywords are matched ignoring case.
+
+type node interface{}
+
+type logical struct {
+	op          string // AND or OR
+	left, right node
+}
+
+type negation struct {
+	x node
+}
+
+type comparison struct {
+	field  Field
+	op     string
+	values []value
+}
+
+type value struct {
+	text string
+	// Time fields: either an absolute time or an offset from now.
+	time     time.Time
+	offset   time.Duration
+	relative bool
+}
+
+type parser struct {
+	toks   []token
+	pos    int
+	depth  int
+	fields Fields
+}
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// keyword reports whether the next token is the keyword kw, and consumes it
+// if so.
+func (p *parser) keyword(kw string) bool {
+	if t := p.peek(); t.kind == tokWord && strings.EqualFold(t.text, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{op: "OR", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{op: "AND", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > MaxDepth {
+		return nil, errorf(p.peek().pos, "filter is nested more than %d levels deep", MaxDepth)
+	}
+
+	if p.keyword("not") {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &negation{x: x}, nil
+	}
+	if p.peek().kind == tokLParen {
+		open := p.next()
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokRParen {
+			return nil, errorf(t.pos, "unexpected %s, expected \")\" to close the \"(\" at column %d", t.describe(), open.pos)
+		}
+		return x, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	name := p.next()
+	if name.kind != tokWord {
+		return nil, errorf(name.pos, "unexpected %s, expected a field name", name.describe())
+	}
+	field, ok := p.fields.lookup(name.text)
+	if !ok {
+		return nil, errorf(name.pos, "unknown field %s; use %s", quote(name.text), p.fields.names())
+	}
+
+	opTok := p.peek()
+	var op string
+	switch {
+	case p.keyword("in"):
+		op = "in"
+	case p.keyword("not"):
+		if !p.keyword("in") {
+			t := p.peek()
+			return nil, errorf(t.pos, "unexpected %s, expected in after not", t.describe())
+		}
+		op = "not in"
+	case opTok.kind == tokOp:
+		p.next()
+		op = opTok.text
+		switch op {
+		case "==":
+			op = "="
+		case "<>":
+			op = "!="
+		}
+	default:
+		return nil, errorf(opTok.pos, "unexpected %s, expected an operator after %s", opTok.describe(), name.text)
+	}
+	if !allowed(field.Kind, op) {
+		return nil, errorf(opTok.pos, "operator %s does not apply to %s", quote(op), name.text)
+	}
+
+	c := &comparison{field: field, op: op}
+	if op != "in" && op != "not in" {
+		v, err := p.parseValue(field)
+		if err != nil {
+			return nil, err
+		}
+		c.values = []value{v}
+		return c, nil
+	}
+
+	if t := p.next(); t.kind != tokLParen {
+		return nil, errorf(t.pos, "unexpected %s, expected \"(\" to start the list of values", t.describe())
+	}
+	for {
+		if len(c.values) == MaxValues {
+			return nil, errorf(p.peek().pos, "lists hold at most %d values", MaxValues)
+		}
+		v, err := p.parseValue(field)
+		if err != nil {
+			return nil, err
+		}
+		c.values = append(c.values, v)
+
+		t := p.next()
+		if t.kind == tokRParen {
+			return c, nil
+		}
+		if t.kind != tokComma {
+			return nil, errorf(t.pos, "unexpected %s, expected \",\" or \")\"", t.describe())
+		}
+	}
+}
+
+func allowed(k Kind, op string) bool {
+	switch k {
+	case Text:
+		return op == "=" || op == "!=" || op == "~" || op == "!~" || op == "in" || op == "not in"
+	case Enum:
+		return op == "=" || op == "!=" || op == "in" || op == "not in"
+	case Time:
+		return op == "<" || op == "<=" || op == ">" || op == ">="
+	}
+	return false
+}
+
+func (p *parser) parseValue(field Field) (value, error) {
+	t := p.next()
+	if t.kind != tokWord && t.kind != tokString {
+		return value{}, errorf(t.pos, "unexpected %s, expected a value", t.describe())
+	}
+
+	switch field.Kind {
+	case Enum:
+		for _, v := range field.Values {
+			if strings.EqualFold(v, t.text) {
+				return value{text: v}, nil
+			}
+		}
+		return value{}, errorf(t.pos, "invalid value %s; use %s", quote(t.text), strings.Join(field.Values, ", "))
+	case Time:
+		return parseTime(t)
+	}
+	return value{text: t.text}, nil
+}
+
+// durationUnits are the units of relative times.
+var durationUnits = map[byte]time.Duration{
+	's': time.Second,
+	'm': time.Minute,
+	'h': time.Hour,
+	'd': 24 * time.Hour,
+	'w': 7 * 24 * time.Hour,
+}
+
+// maxOffset bounds the number in an offset so that even in weeks it fits in
+// a time.Duration.
+const maxOffset = 10_000
+
+func parseTime(t token) (value, error) {
+	s := t.text
+	if strings.EqualFold(s, "now") {
+		return value{relative: true}, nil
+	}
+	if ts, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return value{time: ts}, nil
+	}
+	if ts, err := time.Parse(time.DateOnly, s); err == nil {
+		return value{time: ts}, nil
+	}
+
+	if len(s) >= 3 && (s[0] == '-' || s[0] == '+') {
+		unit, ok := durationUnits[s[len(s)-1]]
+		n, err := strconv.Atoi(s[1 : len(s)-1])
+		if ok && err == nil && n >= 0 && n <= maxOffset && s[1] != '+' && s[1] != '-' {
+			d := time.Duration(n) * unit
+			if s[0] == '-' {
+				d = -d
+			}
+			return value{offset: d, relative: true}, nil
+		}
+	}
+	return value{}, errorf(t.pos, "invalid time %s; use an RFC 3339 time, a date, now or an offset such as -7d", quote(s))
+}
@@ -1,8 +1,13 @@
 package helpers
 
 import (
+	"context"
 	"encoding/json"
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/requestid"
 	"full-stack-assesment/internal/scheme"
+	"log/slog"
+	"mime"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -19,19 +24,111 @@ func WriteJSON(w http.ResponseWriter, status int, v any) {
 	_ = json.NewEncoder(w).Encode(v)
 }
 
-func writeError(w http.ResponseWriter, status int, msg string, details ...string) {
-	WriteJSON(w, status, scheme.Error{Code: status, Message: msg})
+// WriteError maps err onto the error catalog and writes it either as the
+// Error schema or, when the client prefers it, as RFC 7807 problem+json.
+// Server-side failures are logged with their underlying cause.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	apiErr := apierrors.From(err)
+	if apiErr.Status >= http.StatusInternalServerError {
+		slog.ErrorContext(r.Context(), "request failed",
+			slog.String("code", string(apiErr.Code)),
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.Any("error", err),
+		)
+	}
+
+	body := ErrorBody(r.Context(), apiErr)
+	if WantsProblemJSON(r) {
+		instance := r.URL.Path
+		w.Header().Set("Content-Type", ProblemContentType)
+		w.WriteHeader(apiErr.Status)
+		_ = json.NewEncoder(w).Encode(scheme.Problem{
+			Type:     "urn:todo:problem:" + body.Code,
+			Title:    body.Message,
+			Status:   apiErr.Status,
+			Code:     body.Code,
+			Instance: &instance,
+			Details:  body.Details,
+			TraceId:  body.TraceId,
+		})
+		return
+	}
+
+	WriteJSON(w, apiErr.Status, body)
+}
+
+// ErrorBody renders apiErr as the Error envelope, tagged with the trace ID of
+// ctx.
+func ErrorBody(ctx context.Context, apiErr *apierrors.Error) scheme.Error {
+	var details *[]scheme.ErrorDetail
+	if len(apiErr.Details) > 0 {
+		d := make([]scheme.ErrorDetail, 0, len(apiErr.Details))
+		for _, fe := range apiErr.Details {
+			d = append(d, scheme.ErrorDetail{Field: fe.Field, Message: fe.Message})
+		}
+		details = &d
+	}
+
+	var traceID *string
+	if id := requestid.TraceID(ctx); id != "" {
+		traceID = &id
+	}
+
+	return scheme.Error{
+		Code:    string(apiErr.Code),
+		Message: apiErr.Message,
+		Details: details,
+		TraceId: traceID,
+	}
 }
 
-func WriteError(w http.ResponseWriter, status int, msg string) {
-	WriteJSON(w, status, scheme.Error{
-		Code:    status,
-		Message: msg,
-	})
+// ProblemContentType is the media type of RFC 7807 error bodies.
+const ProblemContentType = "application/problem+json"
+
+// WantsProblemJSON reports whether the Accept header prefers problem+json over
+// plain JSON.
+func WantsProblemJSON(r *http.Request) bool {
+	accept := r.Header.Get("Accept")
+	if accept == "" {
+		return false
+	}
+	var problemQ, jsonQ float64 = -1, -1
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		switch mediaType {
+		case ProblemContentType:
+			problemQ = max(problemQ, q)
+		case "application/json":
+			jsonQ = max(jsonQ, q)
+		}
+	}
+	return problemQ > 0 && problemQ >= jsonQ
 }
 
 func NowRFC3339() string { return time.Now().UTC().Format(time.RFC3339Nano) }
 
+// FormatTime renders t the way timestamps are stored in the database: UTC,
+// RFC 3339 with a fixed-width fraction so that string order matches time order.
+func FormatTime(t time.Time) string { return t.UTC().Format("2006-01-02T15:04:05.000000000Z07:00") }
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ContainsPattern matches s anywhere in a LIKE ... ESCAPE '\' comparison,
+// with LIKE's wildcards in s taken literally.
+func ContainsPattern(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
+
 func ParseTimeOrNow(s string) time.Time {
 	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
 		return t
@@ -43,7 +140,7 @@ func ParseUUIDParam(w http.ResponseWriter, r *http.Request, name string) (uuid.U
 	raw := strings.TrimSpace(r.PathValue(name))
 	u, err := uuid.Parse(raw)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, name+" must be a valid uuid")
+		WriteError(w, r, apierrors.InvalidParameter(name, "must be a valid uuid"))
 		return uuid.Nil, false
 	}
 	return u, true
@@ -70,12 +167,12 @@ func ClampInt(v, min, max, def int) int {
 	return v
 }
 
-func ParseLimitOffset(q url.Values) (limit, offset int) {
-	limit = 50
+func ParseLimitOffset(q url.Values, defaultLimit, maxLimit int) (limit, offset int) {
+	limit = defaultLimit
 	offset = 0
 	if s := q.Get("limit"); s != "" {
 		if n, err := strconv.Atoi(s); err == nil {
-			limit = ClampInt(n, 1, 200, 50)
+			limit = ClampInt(n, 1, maxLimit, defaultLimit)
 		}
 	}
 	if s := q.Get("offset"); s != "" {
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+)
+
+// HTTP holds the request metrics recorded by the HTTP middlewares.
+type HTTP struct {
+	Requests *Counter
+	Duration *Histogram
+	InFlight *Gauge
+	Panics   *Counter
+}
+
+func NewHTTP(r *Registry) *HTTP {
+	return &HTTP{
+		Requests: r.Counter("http_requests_total", "HTTP requests handled, by operation, method and status code.", "operation", "method", "code"),
+		Duration: r.Histogram("http_request_duration_seconds", "HTTP request latency in seconds, by operation and method.", DefaultBuckets, "operation", "method"),
+		InFlight: r.Gauge("http_requests_in_flight", "HTTP requests currently being served."),
+		Panics:   r.Counter("http_panics_recovered_total", "Panics recovered while serving HTTP requests."),
+	}
+}
+
+// RegisterDBStats exposes database/sql pool statistics, labelled by pool name.
+func RegisterDBStats(r *Registry, stats func() map[string]sql.DBStats) {
+	pool := []string{"pool"}
+	gauge := func(name, help string, value func(sql.DBStats) float64) {
+		r.Collect(name, help, KindGauge, pool, poolSamples(stats, value))
+	}
+	counter := func(name, help string, value func(sql.DBStats) float64) {
+		r.Collect(name, help, KindCounter, pool, poolSamples(stats, value))
+	}
+
+	gauge("db_max_open_connections", "Maximum number of open connections to the database.",
+		func(s sql.DBStats) float64 { return float64(s.MaxOpenConnections) })
+	gauge("db_open_connections", "Established connections, both in use and idle.",
+		func(s sql.DBStats) float64 { return float64(s.OpenConnections) })
+	gauge("db_in_use_connections", "Connections currently in use.",
+		func(s sql.DBStats) float64 { return float64(s.InUse) })
+	gauge("db_idle_connections", "Idle connections.",
+		func(s sql.DBStats) float64 { return float64(s.Idle) })
+	counter("db_wait_count_total", "Connections waited for.",
+		func(s sql.DBStats) float64 { return float64(s.WaitCount) })
+	counter("db_wait_duration_seconds_total", "Time spent waiting for a connection.",
+		func(s sql.DBStats) float64 { return s.WaitDuration.Seconds() })
+	counter("db_max_idle_closed_total", "Connections closed due to the idle connection limit.",
+		func(s sql.DBStats) float64 { return float64(s.MaxIdleClosed) })
+	counter("db_max_idle_time_closed_total", "Connections closed due to the idle time limit.",
+		func(s sql.DBStats) float64 { return float64(s.MaxIdleTimeClosed) })
+	counter("db_max_lifetime_closed_total", "Connections closed due to the lifetime limit.",
+		func(s sql.DBStats) float64 { return float64(s.MaxLifetimeClosed) })
+}
+
+func poolSamples(stats func() map[string]sql.DBStats, value func(sql.DBStats) float64) CollectFunc {
+	return func(context.Context) ([]Sample, error) {
+		pools := stats()
+		samples := make([]Sample, 0, len(pools))
+		for name, s := range pools {
+			samples = append(samples, Sample{LabelValues: []string{name}, Value: value(s)})
+		}
+		return samples, nil
+	}
+}
@@ -0,0 +1,381 @@
+// Package metrics is a small Prometheus text-format registry. It implements
+// counters, gauges, histograms and scrape-time collectors, which is all the
+// service needs, without pulling in the Prometheus client library.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// ContentType is the Prometheus text exposition format, version 0.0.4.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultBuckets are latency buckets in seconds suited to an API backed by a
+// local database.
+var DefaultBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+
+// Kind is the Prometheus metric type.
+type Kind string
+
+const (
+	KindCounter   Kind = "counter"
+	KindGauge     Kind = "gauge"
+	KindHistogram Kind = "histogram"
+)
+
+// Sample is one value reported by a CollectFunc. LabelValues line up with the
+// label names the collector was registered with.
+type Sample struct {
+	LabelValues []string
+	Value       float64
+}
+
+// CollectFunc produces the current samples of a metric family at scrape time.
+type CollectFunc func(ctx context.Context) ([]Sample, error)
+
+type family interface {
+	name() string
+	write(ctx context.Context, w io.Writer) error
+}
+
+// Registry holds metric families and renders them for scraping.
+type Registry struct {
+	mu       sync.Mutex
+	families map[string]family
+}
+
+func NewRegistry() *Registry {
+	return &Registry{families: map[string]family{}}
+}
+
+func (r *Registry) register(f family) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, dup := r.families[f.name()]; dup {
+		panic("metrics: duplicate metric " + f.name())
+	}
+	r.families[f.name()] = f
+}
+
+// Counter registers a counter vector.
+func (r *Registry) Counter(name, help string, labels ...string) *Counter {
+	c := &Counter{vec: newVec[*atomicFloat](name, help, KindCounter, labels, func() *atomicFloat { return new(atomicFloat) })}
+	r.register(c.vec)
+	return c
+}
+
+// Gauge registers a gauge vector.
+func (r *Registry) Gauge(name, help string, labels ...string) *Gauge {
+	g := &Gauge{vec: newVec[*atomicFloat](name, help, KindGauge, labels, func() *atomicFloat { return new(atomicFloat) })}
+	r.register(g.vec)
+	return g
+}
+
+// Histogram registers a histogram vector with the given upper bounds.
+func (r *Registry) Histogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	buckets = slices.Clone(buckets)
+	sort.Float64s(buckets)
+	h := &Histogram{vec: newVec[*histogram](name, help, KindHistogram, labels, func() *histogram {
+		return &histogram{bounds: buckets, counts: make([]uint64, len(buckets))}
+	})}
+	r.register(h.vec)
+	return h
+}
+
+// Collect registers a family whose samples are produced by fn on every
+// scrape, for values that already live elsewhere such as pool statistics or
+// row counts. A failing fn is logged and its family left out of the scrape.
+func (r *Registry) Collect(name, help string, kind Kind, labels []string, fn CollectFunc) {
+	r.register(&collector{metricName: name, help: help, kind: kind, labels: labels, fn: fn})
+}
+
+// Write renders every family, sorted by name.
+func (r *Registry) Write(ctx context.Context, w io.Writer) error {
+	r.mu.Lock()
+	families := make([]family, 0, len(r.families))
+	for _, f := range r.families {
+		families = append(families, f)
+	}
+	r.mu.Unlock()
+	sort.Slice(families, func(i, j int) bool { return families[i].name() < families[j].name() })
+
+	for _, f := range families {
+		if err := f.write(ctx, w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Handler serves the registry in the text exposition format.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var b strings.Builder
+		if err := r.Write(req.Context(), &b); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", ContentType)
+		_, _ = io.WriteString(w, b.String())
+	})
+}
+
+// Counter is a monotonically increasing value per label set.
+type Counter struct{ vec *vec[*atomicFloat] }
+
+func (c *Counter) Inc(labelValues ...string) { c.Add(1, labelValues...) }
+
+// Add increases the counter by v, which must not be negative.
+func (c *Counter) Add(v float64, labelValues ...string) {
+	if v < 0 {
+		panic("metrics: counter decreased")
+	}
+	c.vec.get(labelValues).add(v)
+}
+
+// Gauge is a value per label set that can go up and down.
+type Gauge struct{ vec *vec[*atomicFloat] }
+
+func (g *Gauge) Inc(labelValues ...string)            { g.vec.get(labelValues).add(1) }
+func (g *Gauge) Dec(labelValues ...string)            { g.vec.get(labelValues).add(-1) }
+func (g *Gauge) Set(v float64, labelValues ...string) { g.vec.get(labelValues).set(v) }
+
+// Histogram counts observations into cumulative buckets per label set.
+type Histogram struct{ vec *vec[*histogram] }
+
+func (h *Histogram) Observe(v float64, labelValues ...string) { h.vec.get(labelValues).observe(v) }
+
+type series interface {
+	samples(name string, labels string, w io.Writer) error
+}
+
+// vec is a metric family keyed by label values.
+type vec[S series] struct {
+	metricName string
+	help       string
+	kind       Kind
+	labels     []string
+	newSeries  func() S
+
+	mu     sync.RWMutex
+	series map[string]S
+	keys   map[string][]string
+}
+
+func newVec[S series](name, help string, kind Kind, labels []string, newSeries func() S) *vec[S] {
+	v := &vec[S]{
+		metricName: name,
+		help:       help,
+		kind:       kind,
+		labels:     labels,
+		newSeries:  newSeries,
+		series:     map[string]S{},
+		keys:       map[string][]string{},
+	}
+	// Unlabelled metrics have exactly one series; expose it as zero from
+	// the start instead of only after the first update.
+	if len(labels) == 0 {
+		v.get(nil)
+	}
+	return v
+}
+
+func (v *vec[S]) name() string { return v.metricName }
+
+func (v *vec[S]) get(values []string) S {
+	if len(values) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", v.metricName, len(v.labels), len(values)))
+	}
+	key := strings.Join(values, "\xff")
+
+	v.mu.RLock()
+	s, ok := v.series[key]
+	v.mu.RUnlock()
+	if ok {
+		return s
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if s, ok = v.series[key]; !ok {
+		s = v.newSeries()
+		v.series[key] = s
+		v.keys[key] = slices.Clone(values)
+	}
+	return s
+}
+
+func (v *vec[S]) write(_ context.Context, w io.Writer) error {
+	v.mu.RLock()
+	keys := make([]string, 0, len(v.series))
+	for k := range v.series {
+		keys = append(keys, k)
+	}
+	v.mu.RUnlock()
+	sort.Strings(keys)
+
+	if err := writeHeader(w, v.metricName, v.help, v.kind); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		v.mu.RLock()
+		s, values := v.series[k], v.keys[k]
+		v.mu.RUnlock()
+		if err := s.samples(v.metricName, formatLabels(v.labels, values), w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type atomicFloat struct{ bits atomic.Uint64 }
+
+func (f *atomicFloat) add(d float64) {
+	for {
+		old := f.bits.Load()
+		if f.bits.CompareAndSwap(old, math.Float64bits(math.Float64frombits(old)+d)) {
+			return
+		}
+	}
+}
+
+func (f *atomicFloat) set(v float64) { f.bits.Store(math.Float64bits(v)) }
+
+func (f *atomicFloat) samples(name, labels string, w io.Writer) error {
+	return writeSample(w, name, labels, math.Float64frombits(f.bits.Load()))
+}
+
+type histogram struct {
+	mu     sync.Mutex
+	bounds []float64
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func (h *histogram) observe(v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if i := sort.SearchFloat64s(h.bounds, v); i < len(h.bounds) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += v
+}
+
+func (h *histogram) samples(name, labels string, w io.Writer) error {
+	h.mu.Lock()
+	counts, count, sum := slices.Clone(h.counts), h.count, h.sum
+	h.mu.Unlock()
+
+	var cumulative uint64
+	for i, bound := range h.bounds {
+		cumulative += counts[i]
+		if err := writeSample(w, name+"_bucket", withLabel(labels, "le", formatFloat(bound)), float64(cumulative)); err != nil {
+			return err
+		}
+	}
+	if err := writeSample(w, name+"_bucket", withLabel(labels, "le", "+Inf"), float64(count)); err != nil {
+		return err
+	}
+	if err := writeSample(w, name+"_sum", labels, sum); err != nil {
+		return err
+	}
+	return writeSample(w, name+"_count", labels, float64(count))
+}
+
+type collector struct {
+	metricName string
+	help       string
+	kind       Kind
+	labels     []string
+	fn         CollectFunc
+}
+
+func (c *collector) name() string { return c.metricName }
+
+func (c *collector) write(ctx context.Context, w io.Writer) error {
+	samples, err := c.fn(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "metrics collector failed", slog.String("metric", c.metricName), slog.Any("error", err))
+		return nil
+	}
+	if err := writeHeader(w, c.metricName, c.help, c.kind); err != nil {
+		return err
+	}
+	for _, s := range samples {
+		if len(s.LabelValues) != len(c.labels) {
+			return fmt.Errorf("metrics: %s expects %d label values, got %d", c.metricName, len(c.labels), len(s.LabelValues))
+		}
+		if err := writeSample(w, c.metricName, formatLabels(c.labels, s.LabelValues), s.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeHeader(w io.Writer, name, help string, kind Kind) error {
+	_, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, escapeHelp(help), name, kind)
+	return err
+}
+
+func writeSample(w io.Writer, name, labels string, v float64) error {
+	if labels != "" {
+		labels = "{" + labels + "}"
+	}
+	_, err := fmt.Fprintf(w, "%s%s %s\n", name, labels, formatFloat(v))
+	return err
+}
+
+func formatLabels(names, values []string) string {
+	var b strings.Builder
+	for i, n := range names {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(n)
+		b.WriteString(`="`)
+		b.WriteString(escapeLabel(values[i]))
+		b.WriteByte('"')
+	}
+	return b.String()
+}
+
+func withLabel(labels, name, value string) string {
+	l := name + `="` + value + `"`
+	if labels == "" {
+		return l
+	}
+	return labels + "," + l
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeLabel(s string) string { return labelEscaper.Replace(s) }
+func escapeHelp(s string) string  { return helpEscaper.Replace(s) }
@@ -0,0 +1,110 @@
+package metrics_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"full-stack-assesment/internal/metrics"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Registry", func() {
+	var reg *metrics.Registry
+
+	scrape := func() string {
+		var b strings.Builder
+		Expect(reg.Write(context.Background(), &b)).To(Succeed())
+		return b.String()
+	}
+
+	BeforeEach(func() {
+		reg = metrics.NewRegistry()
+	})
+
+	It("renders counters and gauges in the text format", func() {
+		c := reg.Counter("jobs_total", "Jobs run.", "kind")
+		c.Inc("import")
+		c.Add(2, "import")
+		c.Inc("export")
+		g := reg.Gauge("queue_depth", "Jobs waiting.")
+		g.Set(7)
+		g.Dec()
+
+		Expect(scrape()).To(Equal(`# HELP jobs_total Jobs run.
+# TYPE jobs_total counter
+jobs_total{kind="export"} 1
+jobs_total{kind="import"} 3
+# HELP queue_depth Jobs waiting.
+# TYPE queue_depth gauge
+queue_depth 6
+`))
+	})
+
+	It("exposes unlabelled metrics before their first update", func() {
+		reg.Counter("panics_total", "Panics.")
+		Expect(scrape()).To(ContainSubstring("\npanics_total 0\n"))
+	})
+
+	It("renders cumulative histogram buckets", func() {
+		h := reg.Histogram("latency_seconds", "Latency.", []float64{0.1, 1}, "op")
+		h.Observe(0.05, "get")
+		h.Observe(0.1, "get")
+		h.Observe(0.5, "get")
+		h.Observe(3, "get")
+
+		Expect(scrape()).To(Equal(`# HELP latency_seconds Latency.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{op="get",le="0.1"} 2
+latency_seconds_bucket{op="get",le="1"} 3
+latency_seconds_bucket{op="get",le="+Inf"} 4
+latency_seconds_sum{op="get"} 3.65
+latency_seconds_count{op="get"} 4
+`))
+	})
+
+	It("escapes label values", func() {
+		reg.Counter("odd_total", "Odd labels.", "v").Inc("a\"b\\c\nd")
+		Expect(scrape()).To(ContainSubstring(`odd_total{v="a\"b\\c\nd"} 1`))
+	})
+
+	It("runs collectors at scrape time and skips failing ones", func() {
+		calls := 0
+		reg.Collect("rows", "Rows.", metrics.KindGauge, []string{"table"}, func(context.Context) ([]metrics.Sample, error) {
+			calls++
+			return []metrics.Sample{{LabelValues: []string{"tasks"}, Value: float64(calls)}}, nil
+		})
+		reg.Collect("broken", "Broken.", metrics.KindGauge, nil, func(context.Context) ([]metrics.Sample, error) {
+			return nil, errors.New("boom")
+		})
+
+		Expect(scrape()).To(ContainSubstring(`rows{table="tasks"} 1`))
+		out := scrape()
+		Expect(out).To(ContainSubstring(`rows{table="tasks"} 2`))
+		Expect(out).NotTo(ContainSubstring("broken"))
+	})
+
+	It("rejects duplicate names and wrong label counts", func() {
+		c := reg.Counter("dup_total", "Dup.", "a")
+		Expect(func() { reg.Gauge("dup_total", "Dup.") }).To(Panic())
+		Expect(func() { c.Inc() }).To(Panic())
+	})
+
+	It("serves the registry over HTTP", func() {
+		metrics.RegisterDBStats(reg, func() map[string]sql.DBStats {
+			return map[string]sql.DBStats{"writer": {MaxOpenConnections: 1, OpenConnections: 1, Idle: 1}}
+		})
+		rr := httptest.NewRecorder()
+		reg.Handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		Expect(rr.Header().Get("Content-Type")).To(Equal(metrics.ContentType))
+		Expect(rr.Body.String()).To(ContainSubstring(`db_max_open_connections{pool="writer"} 1`))
+		Expect(rr.Body.String()).To(ContainSubstring("# TYPE db_wait_count_total counter"))
+	})
+})
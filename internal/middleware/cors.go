@@ -2,24 +2,42 @@ package middleware
 
 import (
 	"net/http"
+	"slices"
 )
 
-// CORSMiddleware is a middleware that adds CORS headers to the response.
-func CORSMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Set CORS headers
-		w.Header().Set("Access-Control-Allow-Origin", "http://localhost:5173")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS, PATCH")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-		w.Header().Set("Access-Control-Allow-Credentials", "true")
+// CORSMiddleware returns a middleware that adds CORS headers to the response
+// for requests coming from one of allowedOrigins. "*" allows any origin, but
+// without credentials; only origins listed by name may send them.
+func CORSMiddleware(allowedOrigins []string) func(http.Handler) http.Handler {
+	allowAny := slices.Contains(allowedOrigins, "*")
 
-		// Handle preflight OPTIONS request
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", "Origin")
 
-		// Continue to next handler
-		next.ServeHTTP(w, r)
-	})
+			// Set CORS headers
+			origin := r.Header.Get("Origin")
+			listed := slices.Contains(allowedOrigins, origin)
+			if origin != "" && (allowAny || listed) {
+				if listed {
+					w.Header().Set("Access-Control-Allow-Origin", origin)
+					w.Header().Set("Access-Control-Allow-Credentials", "true")
+				} else {
+					w.Header().Set("Access-Control-Allow-Origin", "*")
+				}
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS, PATCH")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID, traceparent, If-Match, If-None-Match, Idempotency-Key")
+				w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, traceparent, X-Total-Count, Link, ETag, Idempotent-Replayed")
+			}
+
+			// Handle preflight OPTIONS request
+			if r.Method == "OPTIONS" {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+
+			// Continue to next handler
+			next.ServeHTTP(w, r)
+		})
+	}
 }
@@ -0,0 +1,161 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/helpers"
+	repo "full-stack-assesment/internal/repo/idempotency"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client-chosen key that makes a POST
+	// safe to retry.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a response replayed from a stored key.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// replayedHeaders are the response headers stored with a key and sent again
+// on replay. Per-request headers such as X-Request-ID are left to the
+// middleware that sets them.
+var replayedHeaders = []string{"Content-Type", "Location", "ETag"}
+
+// IdempotencyMiddleware makes POST requests carrying an Idempotency-Key safe
+// to retry. The first request with a key runs; if it succeeds, its response
+// is kept for ttl and replayed, marked Idempotent-Replayed: true, to every
+// retry with the same key. A retry with a different method, path or body is
+// rejected with 422 IDEMPOTENCY_KEY_REUSED, and one arriving while the first
+// request still runs with 409 IDEMPOTENCY_KEY_IN_PROGRESS. Failed requests
+// release their key so that a retry runs again.
+func IdempotencyMiddleware(keys *repo.SQLiteIdempotencyRepo, ttl time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				helpers.WriteError(w, r, apierrors.InvalidParameter(IdempotencyKeyHeader, "must be at most 255 characters"))
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				helpers.WriteError(w, r, apierrors.ErrMalformedBody)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			fingerprint := requestFingerprint(r, body)
+			now := time.Now().UTC()
+			rec, claimed, err := keys.Claim(r.Context(), key, fingerprint, now, now.Add(ttl))
+			if err != nil {
+				helpers.WriteError(w, r, err)
+				return
+			}
+			if !claimed {
+				switch {
+				case rec.Fingerprint != fingerprint:
+					helpers.WriteError(w, r, apierrors.ErrIdempotencyKeyReused)
+				case rec.Status == 0:
+					w.Header().Set("Retry-After", "1")
+					helpers.WriteError(w, r, apierrors.ErrIdempotencyKeyInProgress)
+				default:
+					replay(w, rec)
+				}
+				return
+			}
+
+			// The outcome is recorded even when the client has gone away,
+			// and a panicking handler still releases the key, so a key is
+			// never left claimed until it expires.
+			ctx := context.WithoutCancel(r.Context())
+			settled := false
+			defer func() {
+				if !settled {
+					release(ctx, keys, key)
+				}
+			}()
+
+			buf := &bufferedWriter{header: http.Header{}, status: http.StatusOK}
+			next.ServeHTTP(buf, r)
+			settled = true
+
+			if buf.status >= 200 && buf.status < 300 {
+				if err := keys.Complete(ctx, key, buf.status, keptHeaders(buf.header), buf.body.Bytes()); err != nil {
+					slog.WarnContext(ctx, "store idempotent response", slog.Any("error", err))
+					release(ctx, keys, key)
+				}
+			} else {
+				release(ctx, keys, key)
+			}
+			buf.flushTo(w)
+		})
+	}
+}
+
+func release(ctx context.Context, keys *repo.SQLiteIdempotencyRepo, key string) {
+	if err := keys.Release(ctx, key); err != nil {
+		slog.WarnContext(ctx, "release idempotency key", slog.Any("error", err))
+	}
+}
+
+func replay(w http.ResponseWriter, rec repo.Record) {
+	for k, v := range rec.Header {
+		w.Header()[k] = v
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(rec.Status)
+	_, _ = w.Write(rec.Body)
+}
+
+func keptHeaders(h http.Header) http.Header {
+	out := http.Header{}
+	for _, k := range replayedHeaders {
+		if v := h.Values(k); len(v) > 0 {
+			out[http.CanonicalHeaderKey(k)] = v
+		}
+	}
+	return out
+}
+
+// requestFingerprint identifies what a request asks for: its method, target
+// and body. JSON bodies are compared by value, so a retry that re-encodes
+// the same document with other spacing or key order still matches.
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, r.Method+" "+r.URL.RequestURI()+"\n")
+	h.Write(canonicalJSON(body))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// canonicalJSON re-encodes a JSON document with sorted keys and no spacing,
+// keeping numbers as written. Anything else is returned unchanged.
+func canonicalJSON(body []byte) []byte {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return body
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return out
+}
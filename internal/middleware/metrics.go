@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"full-stack-assesment/internal/metrics"
+)
+
+// MetricsMiddleware records request counts, latency and in-flight requests.
+// It must wrap the ServeMux directly: the mux sets r.Pattern on the request it
+// is handed, and operation turns that into the label once routing is done.
+func MetricsMiddleware(m *metrics.HTTP, operation func(*http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			m.InFlight.Inc()
+			defer m.InFlight.Dec()
+
+			start := time.Now()
+			rw := newResponseWriter(w)
+			next.ServeHTTP(rw, r)
+
+			op := operation(r)
+			m.Requests.Inc(op, r.Method, strconv.Itoa(rw.statusCode))
+			m.Duration.Observe(time.Since(start).Seconds(), op, r.Method)
+		})
+	}
+}
@@ -1,28 +1,36 @@
 package middleware
 
 import (
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/metrics"
 	"log/slog"
 	"net/http"
 	"runtime/debug"
 )
 
-func RecoverMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		defer func() {
-			if rec := recover(); rec != nil {
-				slog.Error("panic recovered",
-					"panic", rec,
-					"path", r.URL.Path,
-					"method", r.Method,
-					"stack", string(debug.Stack()),
-				)
+// RecoverMiddleware turns panics into INTERNAL_ERROR responses and counts them
+// in panics, which may be nil.
+func RecoverMiddleware(panics *metrics.Counter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if rec := recover(); rec != nil {
+					if panics != nil {
+						panics.Inc()
+					}
+					slog.ErrorContext(r.Context(), "panic recovered",
+						"panic", rec,
+						"path", r.URL.Path,
+						"method", r.Method,
+						"stack", string(debug.Stack()),
+					)
 
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusInternalServerError)
-				_, _ = w.Write([]byte(`{"error":"internal server error"}`))
-			}
-		}()
+					helpers.WriteError(w, r, apierrors.ErrInternal)
+				}
+			}()
 
-		next.ServeHTTP(w, r)
-	})
+			next.ServeHTTP(w, r)
+		})
+	}
 }
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"full-stack-assesment/internal/requestid"
+	"net/http"
+)
+
+// RequestIDMiddleware accepts or generates the request's X-Request-ID and W3C
+// traceparent, stores them in the request context for logs and error bodies,
+// and echoes them in the response headers. It should wrap every other
+// middleware so panics and access logs are correlated too.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := requestid.New(r.Header.Get(requestid.Header), r.Header.Get(requestid.TraceparentHeader))
+
+		w.Header().Set(requestid.Header, ids.RequestID)
+		w.Header().Set(requestid.TraceparentHeader, ids.Traceparent())
+
+		next.ServeHTTP(w, r.WithContext(requestid.NewContext(r.Context(), ids)))
+	})
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"full-stack-assesment/internal/telemetry"
+)
+
+// TracingMiddleware starts the server span of each request. Like
+// MetricsMiddleware it must wrap the ServeMux directly so the span can be
+// named after the routed operation. Time spent encoding and writing the
+// response body gets its own child span, started when the handler writes the
+// status line.
+func TracingMiddleware(operation func(*http.Request) string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, span := telemetry.Default().Start(r.Context(), r.Method, telemetry.KindServer,
+				telemetry.String("http.request.method", r.Method),
+				telemetry.String("url.path", r.URL.Path),
+			)
+			if span == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			tw := &tracingWriter{responseWriter: newResponseWriter(w), ctx: ctx}
+			// The mux sets r.Pattern on the request it is given, so route the
+			// copy and read the pattern from it afterwards.
+			routed := r.WithContext(ctx)
+			next.ServeHTTP(tw, routed)
+			tw.writeSpan.End(nil)
+			// Hand the route back to outer middleware such as MetricsMiddleware.
+			r.Pattern = routed.Pattern
+
+			span.SetName(r.Method + " " + operation(routed))
+			span.SetAttributes(
+				telemetry.String("http.route", routed.Pattern),
+				telemetry.Int("http.response.status_code", tw.statusCode),
+			)
+			var err error
+			if tw.statusCode >= http.StatusInternalServerError {
+				err = errors.New(strconv.Itoa(tw.statusCode) + " " + http.StatusText(tw.statusCode))
+			}
+			span.End(err)
+		})
+	}
+}
+
+type tracingWriter struct {
+	*responseWriter
+	ctx       context.Context
+	writeSpan *telemetry.Span
+	started   bool
+}
+
+func (tw *tracingWriter) startWrite() {
+	if !tw.started {
+		tw.started = true
+		_, tw.writeSpan = telemetry.Start(tw.ctx, "write response")
+	}
+}
+
+func (tw *tracingWriter) WriteHeader(code int) {
+	tw.startWrite()
+	tw.responseWriter.WriteHeader(code)
+}
+
+func (tw *tracingWriter) Write(b []byte) (int, error) {
+	tw.startWrite()
+	return tw.responseWriter.Write(b)
+}
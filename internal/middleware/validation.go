@@ -0,0 +1,285 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/helpers"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+func init() {
+	// kin-openapi decodes JSON Patch bodies but not JSON Merge Patch ones,
+	// which are plain JSON as well.
+	openapi3filter.RegisterBodyDecoder("application/merge-patch+json", openapi3filter.JSONBodyDecoder)
+}
+
+// ValidationOptions configures ValidationMiddleware.
+type ValidationOptions struct {
+	// ValidateResponses buffers every response of a spec operation and checks
+	// it against the spec before sending it. Meant for tests, to catch drift
+	// between handlers and the spec.
+	ValidateResponses bool
+	// OnResponseError receives responses that do not match the spec. The
+	// response is still sent unchanged. Defaults to logging a warning.
+	OnResponseError func(r *http.Request, err error)
+}
+
+// ValidationMiddleware checks requests against spec before they reach the
+// handlers: parameters that break their schema are rejected with 400
+// INVALID_PARAMETER, unparsable or mistyped bodies with 400 MALFORMED_REQUEST,
+// bodies that parse but break a rule with 422 VALIDATION_FAILED, and bodies in
+// an undeclared media type with 415. Requests that match no operation are
+// passed through so the mux can answer 404/405 or serve non-spec routes.
+func ValidationMiddleware(spec *openapi3.T, opts ValidationOptions) (func(http.Handler) http.Handler, error) {
+	// Route on paths only; the servers list describes deployments, not the
+	// host this process is reached on.
+	paths := *spec
+	paths.Servers = nil
+	router, err := legacy.NewRouter(&paths)
+	if err != nil {
+		return nil, fmt.Errorf("build validation router: %w", err)
+	}
+	if opts.OnResponseError == nil {
+		opts.OnResponseError = func(r *http.Request, err error) {
+			slog.WarnContext(r.Context(), "response does not match the spec",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	filterOpts := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		// Handlers apply their own defaults; writing the spec's into the
+		// query string would leak them into links built from the URL.
+		SkipSettingDefaults: true,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, params, err := router.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: params,
+				Route:      route,
+				Options:    filterOpts,
+			}
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				// The mux never sees rejected requests; record the route it
+				// would have matched so metrics and traces still name the
+				// operation.
+				r.Pattern = route.Method + " " + route.Path
+				helpers.WriteError(w, r, requestValidationError(err, route))
+				return
+			}
+
+			if !opts.ValidateResponses {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rec := &bufferedWriter{header: http.Header{}, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			if err := validateResponse(r.Context(), input, rec); err != nil {
+				opts.OnResponseError(r, err)
+			}
+			rec.flushTo(w)
+		})
+	}, nil
+}
+
+func validateResponse(ctx context.Context, input *openapi3filter.RequestValidationInput, rec *bufferedWriter) error {
+	out := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 rec.status,
+		Header:                 rec.header,
+		Body:                   io.NopCloser(bytes.NewReader(rec.body.Bytes())),
+		Options: &openapi3filter.Options{
+			MultiError:            true,
+			IncludeResponseStatus: true,
+		},
+	}
+	return openapi3filter.ValidateResponse(ctx, out)
+}
+
+// requestValidationError maps validator errors onto the error catalog.
+func requestValidationError(err error, route *routers.Route) error {
+	var (
+		details     []apierrors.FieldError
+		badParam    bool
+		malformed   bool
+		unsupported bool
+		missingBody bool
+	)
+
+	for _, e := range flatten(err) {
+		var reqErr *openapi3filter.RequestError
+		if !errors.As(e, &reqErr) {
+			// Security requirement failures and the like: nothing the spec
+			// declares today, so report them generically.
+			details = append(details, apierrors.FieldError{Message: e.Error()})
+			malformed = true
+			continue
+		}
+
+		switch {
+		case reqErr.Parameter != nil:
+			badParam = true
+			for _, se := range schemaErrors(reqErr.Err) {
+				details = append(details, apierrors.FieldError{Field: reqErr.Parameter.Name, Message: se.Reason})
+			}
+			if len(schemaErrors(reqErr.Err)) == 0 {
+				details = append(details, apierrors.FieldError{Field: reqErr.Parameter.Name, Message: reasonOf(reqErr)})
+			}
+		case errors.Is(reqErr.Err, openapi3filter.ErrInvalidRequired):
+			missingBody = true
+		case reqErr.Err == nil && strings.HasPrefix(reqErr.Reason, "header Content-Type"):
+			unsupported = true
+		default:
+			ses := schemaErrors(reqErr.Err)
+			if len(ses) == 0 {
+				malformed = true
+				continue
+			}
+			for _, se := range ses {
+				if se.SchemaField == "type" {
+					malformed = true
+				}
+				details = append(details, apierrors.FieldError{Field: fieldOf(se), Message: reasonOfSchema(se)})
+			}
+		}
+	}
+
+	switch {
+	case badParam:
+		return apierrors.ErrInvalidParameter.WithMessage(paramMessage(details)).WithDetails(details...)
+	case unsupported:
+		return apierrors.ErrUnsupportedMedia.WithMessage("request body must be " + strings.Join(mediaTypes(route), " or "))
+	case missingBody:
+		return apierrors.ErrMalformedBody.WithMessage("request body is required")
+	case malformed:
+		return apierrors.ErrMalformedBody.WithDetails(details...)
+	default:
+		return apierrors.ErrValidation.WithDetails(details...)
+	}
+}
+
+// mediaTypes lists the request body media types route declares.
+func mediaTypes(route *routers.Route) []string {
+	if route.Operation == nil || route.Operation.RequestBody == nil || route.Operation.RequestBody.Value == nil {
+		return nil
+	}
+	types := make([]string, 0, len(route.Operation.RequestBody.Value.Content))
+	for mt := range route.Operation.RequestBody.Value.Content {
+		types = append(types, mt)
+	}
+	slices.Sort(types)
+	return types
+}
+
+func paramMessage(details []apierrors.FieldError) string {
+	if len(details) == 0 {
+		return apierrors.ErrInvalidParameter.Message
+	}
+	return "invalid " + details[0].Field + ": " + details[0].Message
+}
+
+func reasonOf(e *openapi3filter.RequestError) string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return e.Reason
+}
+
+// flatten unpacks the MultiError trees the validator returns. Only direct
+// MultiErrors are unpacked: errors.As would also reach through a RequestError
+// and lose the parameter or body it is about.
+func flatten(err error) []error {
+	if multi, ok := err.(openapi3.MultiError); ok {
+		var out []error
+		for _, e := range multi {
+			out = append(out, flatten(e)...)
+		}
+		return out
+	}
+	return []error{err}
+}
+
+func schemaErrors(err error) []*openapi3.SchemaError {
+	if err == nil {
+		return nil
+	}
+	var out []*openapi3.SchemaError
+	for _, e := range flatten(err) {
+		var se *openapi3.SchemaError
+		if errors.As(e, &se) {
+			out = append(out, se)
+		}
+	}
+	return out
+}
+
+// reasonOfSchema words missing properties like the handler-side catalog
+// entries; other reasons are the validator's own.
+func reasonOfSchema(se *openapi3.SchemaError) string {
+	if se.SchemaField == "required" {
+		return "is required"
+	}
+	return se.Reason
+}
+
+// fieldOf renders the JSON pointer of a schema error as a dotted path, the
+// form used by FieldError elsewhere.
+func fieldOf(se *openapi3.SchemaError) string {
+	return strings.Join(se.JSONPointer(), ".")
+}
+
+// bufferedWriter holds a response until it has been validated.
+type bufferedWriter struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (b *bufferedWriter) Header() http.Header { return b.header }
+
+func (b *bufferedWriter) WriteHeader(code int) {
+	if !b.wroteHeader {
+		b.status = code
+		b.wroteHeader = true
+	}
+}
+
+func (b *bufferedWriter) Write(p []byte) (int, error) {
+	b.WriteHeader(http.StatusOK)
+	return b.body.Write(p)
+}
+
+func (b *bufferedWriter) flushTo(w http.ResponseWriter) {
+	for k, v := range b.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(b.status)
+	_, _ = w.Write(b.body.Bytes())
+}
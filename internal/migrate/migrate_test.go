@@ -0,0 +1,72 @@
+package migrate_test
+
+import (
+	"context"
+	"path/filepath"
+
+	"full-stack-assesment/internal/migrate"
+	"full-stack-assesment/internal/store"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Migrations", func() {
+	It("roll all the way down and back up", func() {
+		ctx := context.Background()
+		db, err := store.Open(ctx, store.Config{DSN: filepath.Join(GinkgoT().TempDir(), "todo.db")})
+		Expect(err).NotTo(HaveOccurred())
+		defer db.Close()
+
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
+		_, latest, err := migrate.Version(ctx, db.DB)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(migrate.Reset(ctx, db.DB)).To(Succeed())
+		current, _, err := migrate.Version(ctx, db.DB)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current).To(BeZero())
+
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
+		current, _, err = migrate.Version(ctx, db.DB)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current).To(Equal(latest))
+	})
+
+	It("rolls subtasks back by rebuilding the tasks table", func() {
+		ctx := context.Background()
+		db, err := store.Open(ctx, store.Config{DSN: filepath.Join(GinkgoT().TempDir(), "todo.db")})
+		Expect(err).NotTo(HaveOccurred())
+		defer db.Close()
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
+
+		_, err = db.ExecContext(ctx, `
+			INSERT INTO tasks (id, project_id, parent_id, title, status, created_at, updated_at)
+			VALUES ('sub', 'aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa', 'bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb', 'Subtask', 'TODO', 'x', 'x')`)
+		Expect(err).NotTo(HaveOccurred())
+
+		for {
+			current, _, err := migrate.Version(ctx, db.DB)
+			Expect(err).NotTo(HaveOccurred())
+			if current < 8 {
+				break
+			}
+			Expect(migrate.DownOne(ctx, db.DB)).To(Succeed())
+		}
+
+		var columns int
+		Expect(db.QueryRowContext(ctx, `SELECT COUNT(*) FROM pragma_table_info('tasks') WHERE name = 'parent_id'`).Scan(&columns)).To(Succeed())
+		Expect(columns).To(BeZero())
+		var tasks int
+		Expect(db.QueryRowContext(ctx, `SELECT COUNT(*) FROM tasks`).Scan(&tasks)).To(Succeed())
+		Expect(tasks).To(Equal(3))
+
+		_, err = db.ExecContext(ctx, `UPDATE tasks SET title = 'Renamed subtask' WHERE id = 'sub'`)
+		Expect(err).NotTo(HaveOccurred())
+		var title string
+		Expect(db.QueryRowContext(ctx, `SELECT title FROM tasks_fts WHERE task_id = 'sub'`).Scan(&title)).To(Succeed())
+		Expect(title).To(Equal("Renamed subtask"))
+
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
+	})
+})
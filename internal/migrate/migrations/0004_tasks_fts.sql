@@ -0,0 +1,50 @@
+-- +goose Up
+-- Full-text index over task titles and descriptions. The index keeps its own
+-- copy of the text instead of pointing at tasks by rowid: tasks has a TEXT
+-- primary key, so its rowids are not stable across VACUUM.
+CREATE VIRTUAL TABLE IF NOT EXISTS tasks_fts USING fts5(
+    task_id UNINDEXED,
+    project_id UNINDEXED,
+    title,
+    description,
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+
+INSERT INTO tasks_fts (task_id, project_id, title, description)
+SELECT id, project_id, title, COALESCE(description, '') FROM tasks;
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS tasks_fts_after_insert AFTER INSERT ON tasks
+BEGIN
+    INSERT INTO tasks_fts (task_id, project_id, title, description)
+    VALUES (new.id, new.project_id, new.title, COALESCE(new.description, ''));
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS tasks_fts_after_update AFTER UPDATE OF title, description, project_id ON tasks
+BEGIN
+    UPDATE tasks_fts
+    SET project_id = new.project_id,
+        title = new.title,
+        description = COALESCE(new.description, '')
+    WHERE task_id = old.id;
+END;
+-- +goose StatementEnd
+
+-- Also fires for tasks removed by the ON DELETE CASCADE of their project.
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS tasks_fts_after_delete AFTER DELETE ON tasks
+BEGIN
+    DELETE FROM tasks_fts WHERE task_id = old.id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS tasks_fts_after_delete;
+
+DROP TRIGGER IF EXISTS tasks_fts_after_update;
+
+DROP TRIGGER IF EXISTS tasks_fts_after_insert;
+
+DROP TABLE IF EXISTS tasks_fts;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Saved task listings. filter and sort hold the filter and sort parameters
+-- of the task listing, '' when unset; columns is a JSON array of the task
+-- fields clients show for the view.
+CREATE TABLE IF NOT EXISTS task_views (
+    id TEXT PRIMARY KEY,
+    project_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    filter TEXT NOT NULL DEFAULT '',
+    sort TEXT NOT NULL DEFAULT '',
+    columns TEXT NOT NULL DEFAULT '[]',
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    UNIQUE (project_id, name),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_views;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Row versions for optimistic concurrency: every write bumps version, and
+-- conditional writes only apply to the version the client last saw. They
+-- are served as the ETag of the row.
+ALTER TABLE projects ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE tasks ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE tasks DROP COLUMN version;
+ALTER TABLE projects DROP COLUMN version;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Idempotency-Key records. status, header and body stay NULL while the
+-- request that claimed the key runs, then hold the response replayed to
+-- retries until expires_at.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key TEXT PRIMARY KEY,
+    fingerprint TEXT NOT NULL,
+    status INTEGER,
+    header TEXT,
+    body BLOB,
+    created_at TEXT NOT NULL,
+    expires_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_idempotency_keys_expires_at;
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,65 @@
+-- +goose Up
+-- Subtasks: a task may have a parent task of the same project. Deleting a
+-- task deletes its subtasks through the ON DELETE CASCADE, which also fires
+-- the full-text delete trigger for each of them; the service moves them up
+-- to the grandparent first when asked to keep them.
+ALTER TABLE tasks ADD COLUMN parent_id TEXT REFERENCES tasks(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON tasks (parent_id);
+
+-- +goose Down
+-- SQLite refuses to drop a column with a foreign key, so the table is rebuilt
+-- without parent_id. Its indexes and full-text triggers go with the old table
+-- and are created again; subtasks stay, as top-level tasks.
+CREATE TABLE tasks_rebuilt (
+    id TEXT PRIMARY KEY,
+    project_id TEXT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT,
+    status TEXT NOT NULL CHECK (status IN ('TODO', 'IN_PROGRESS', 'DONE')),
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    FOREIGN KEY(project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+INSERT INTO tasks_rebuilt (id, project_id, title, description, status, created_at, updated_at, version)
+SELECT id, project_id, title, description, status, created_at, updated_at, version FROM tasks;
+
+-- Dropping the old table deletes its rows, and the cascade from parents to
+-- subtasks would take their full-text entries with them.
+UPDATE tasks SET parent_id = NULL WHERE parent_id IS NOT NULL;
+
+DROP TABLE tasks;
+
+ALTER TABLE tasks_rebuilt RENAME TO tasks;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_project_status ON tasks (project_id, status, updated_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_tasks_title_like ON tasks (title);
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS tasks_fts_after_insert AFTER INSERT ON tasks
+BEGIN
+    INSERT INTO tasks_fts (task_id, project_id, title, description)
+    VALUES (new.id, new.project_id, new.title, COALESCE(new.description, ''));
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS tasks_fts_after_update AFTER UPDATE OF title, description, project_id ON tasks
+BEGIN
+    UPDATE tasks_fts
+    SET project_id = new.project_id,
+        title = new.title,
+        description = COALESCE(new.description, '')
+    WHERE task_id = old.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER IF NOT EXISTS tasks_fts_after_delete AFTER DELETE ON tasks
+BEGIN
+    DELETE FROM tasks_fts WHERE task_id = old.id;
+END;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Dependencies: blocker_id blocks blocked_id. Either task may be in any
+-- project; deleting either one drops the edge. The service keeps the graph
+-- acyclic.
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    blocker_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    blocked_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    created_at TEXT NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_blocked_id ON task_dependencies(blocked_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_task_dependencies_blocked_id;
+DROP TABLE IF EXISTS task_dependencies;
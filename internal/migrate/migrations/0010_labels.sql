@@ -0,0 +1,29 @@
+-- +goose Up
+-- Per-project label catalog and its many-to-many assignment to tasks. Label
+-- names compare without regard to case, so Bug and bug are the same label.
+-- Tasks refer to labels by ID, so renames show on every task at once and
+-- deleting a label takes it off its tasks.
+CREATE TABLE IF NOT EXISTS labels (
+    id TEXT PRIMARY KEY,
+    project_id TEXT NOT NULL,
+    name TEXT NOT NULL COLLATE NOCASE,
+    color TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    UNIQUE (project_id, name),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS task_labels (
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    label_id TEXT NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+    created_at TEXT NOT NULL,
+    PRIMARY KEY (task_id, label_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_labels_label_id ON task_labels(label_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_task_labels_label_id;
+DROP TABLE IF EXISTS task_labels;
+DROP TABLE IF EXISTS labels;
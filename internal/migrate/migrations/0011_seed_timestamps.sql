@@ -0,0 +1,46 @@
+-- +goose Up
+-- The sample rows of 0003 were seeded with second-precision timestamps, while
+-- the application stores them with a fixed-width fraction so that string
+-- order matches time order. Rewrite the ones still as seeded.
+UPDATE projects
+SET
+    created_at = substr(created_at, 1, 19) || '.000000000Z',
+    updated_at = substr(updated_at, 1, 19) || '.000000000Z'
+WHERE
+    id = 'aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa'
+    AND length(created_at) = 20
+    AND length(updated_at) = 20;
+
+UPDATE tasks
+SET
+    created_at = substr(created_at, 1, 19) || '.000000000Z',
+    updated_at = substr(updated_at, 1, 19) || '.000000000Z'
+WHERE
+    id IN (
+        'bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb',
+        'cccccccc-cccc-cccc-cccc-cccccccccccc'
+    )
+    AND length(created_at) = 20
+    AND length(updated_at) = 20;
+
+-- +goose Down
+UPDATE projects
+SET
+    created_at = substr(created_at, 1, 19) || 'Z',
+    updated_at = substr(updated_at, 1, 19) || 'Z'
+WHERE
+    id = 'aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa'
+    AND created_at LIKE '%.000000000Z'
+    AND updated_at LIKE '%.000000000Z';
+
+UPDATE tasks
+SET
+    created_at = substr(created_at, 1, 19) || 'Z',
+    updated_at = substr(updated_at, 1, 19) || 'Z'
+WHERE
+    id IN (
+        'bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb',
+        'cccccccc-cccc-cccc-cccc-cccccccccccc'
+    )
+    AND created_at LIKE '%.000000000Z'
+    AND updated_at LIKE '%.000000000Z';
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"embed"
 	"fmt"
+	"io/fs"
 	"log"
 
 	"github.com/pressly/goose/v3"
@@ -45,3 +46,18 @@ func Reset(ctx context.Context, db *sql.DB) error {
 	}
 	return goose.ResetContext(ctx, db, "migrations")
 }
+
+// Version reports the schema version recorded in the database and the latest
+// version embedded in the binary. It only reads, so it is safe to call from the
+// read pool and concurrently with requests.
+func Version(ctx context.Context, db *sql.DB) (current, latest int64, err error) {
+	sub, err := fs.Sub(migrationsFS, "migrations")
+	if err != nil {
+		return 0, 0, err
+	}
+	provider, err := goose.NewProvider(goose.DialectSQLite3, db, sub)
+	if err != nil {
+		return 0, 0, fmt.Errorf("goose provider: %w", err)
+	}
+	return provider.GetVersions(ctx)
+}
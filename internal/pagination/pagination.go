@@ -0,0 +1,276 @@
+// Package pagination pages through list endpoints with opaque keyset cursors,
+// falling back to LIMIT/OFFSET for clients that still send an offset, and
+// describes neighbouring pages as RFC 8288 Link headers.
+//
+// A keyset cursor holds the sort key values of a row. The next page is the
+// rows strictly after it in the listing's order. Unlike offsets, it does not
+// skip or repeat rows when rows before it are inserted, deleted or re-sorted
+// between requests.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"full-stack-assesment/internal/apierrors"
+)
+
+// ErrInvalidCursor is returned for cursors that were not issued for the
+// listing they are used with.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Key is one column of a sort order.
+type Key struct {
+	Column string
+	Desc   bool
+}
+
+// Order is a total order over the rows of a listing. Its columns together
+// must be unique, which usually means ending with the primary key.
+type Order []Key
+
+// SQL renders o as an ORDER BY list.
+func (o Order) SQL() string {
+	parts := make([]string, len(o))
+	for i, k := range o {
+		parts[i] = k.Column + " ASC"
+		if k.Desc {
+			parts[i] = k.Column + " DESC"
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Columns lists the columns of o, for selecting the cursor values of a row.
+func (o Order) Columns() []string {
+	cols := make([]string, len(o))
+	for i, k := range o {
+		cols[i] = k.Column
+	}
+	return cols
+}
+
+func (o Order) reverse() Order {
+	out := make(Order, len(o))
+	for i, k := range o {
+		out[i] = Key{Column: k.Column, Desc: !k.Desc}
+	}
+	return out
+}
+
+// seek returns the predicate matching the rows after values in o:
+// (a > ?) OR (a = ? AND b > ?) OR ..., with < for descending keys.
+func (o Order) seek(values []string) (string, []any) {
+	var (
+		terms []string
+		args  []any
+	)
+	for i, k := range o {
+		var conds []string
+		for j := range i {
+			conds = append(conds, o[j].Column+" = ?")
+			args = append(args, values[j])
+		}
+		op := " > ?"
+		if k.Desc {
+			op = " < ?"
+		}
+		conds = append(conds, k.Column+op)
+		args = append(args, values[i])
+		terms = append(terms, "("+strings.Join(conds, " AND ")+")")
+	}
+	return "(" + strings.Join(terms, " OR ") + ")", args
+}
+
+type cursor struct {
+	Order  string   `json:"o"`
+	Values []string `json:"v"`
+}
+
+// EncodeCursor returns the cursor positioned at the row with the given sort
+// key values.
+func EncodeCursor(o Order, values []string) string {
+	b, _ := json.Marshal(cursor{Order: o.SQL(), Values: values})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor returns the sort key values in s. It fails when s was issued
+// for a different order.
+func DecodeCursor(o Order, s string) ([]string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Order != o.SQL() || len(c.Values) != len(o) {
+		return nil, ErrInvalidCursor
+	}
+	return c.Values, nil
+}
+
+// Query asks for one page. At most one of After, Before and Offset is set.
+type Query struct {
+	Limit  int
+	Offset int
+	// After and Before are decoded cursors: the page holds the rows strictly
+	// after, or strictly before, that position.
+	After  []string
+	Before []string
+}
+
+// Params are the paging parameters of a list request as bound from the query
+// string.
+type Params struct {
+	Limit  *int
+	Offset *int
+	After  *string
+	Before *string
+}
+
+// ParseQuery validates p against o and the page size limits. A missing or
+// zero limit means defaultLimit; larger ones are capped at maxLimit.
+func ParseQuery(p Params, o Order, defaultLimit, maxLimit int) (Query, error) {
+	q := Query{Limit: defaultLimit}
+	if p.Limit != nil {
+		q.Limit = min(max(*p.Limit, 0), maxLimit)
+		if q.Limit == 0 {
+			q.Limit = defaultLimit
+		}
+	}
+
+	set := 0
+	for _, given := range []bool{p.After != nil, p.Before != nil, p.Offset != nil && *p.Offset > 0} {
+		if given {
+			set++
+		}
+	}
+	if set > 1 {
+		return Query{}, apierrors.InvalidParameter("after", "after, before and offset cannot be combined")
+	}
+
+	var err error
+	switch {
+	case p.After != nil:
+		if q.After, err = DecodeCursor(o, *p.After); err != nil {
+			return Query{}, apierrors.InvalidParameter("after", "is not a cursor of this listing")
+		}
+	case p.Before != nil:
+		if q.Before, err = DecodeCursor(o, *p.Before); err != nil {
+			return Query{}, apierrors.InvalidParameter("before", "is not a cursor of this listing")
+		}
+	case p.Offset != nil:
+		q.Offset = max(*p.Offset, 0)
+	}
+	return q, nil
+}
+
+// Seek returns the keyset predicate of q, or "" when q has no cursor.
+func (q Query) Seek(o Order) (string, []any) {
+	switch {
+	case q.After != nil:
+		return o.seek(q.After)
+	case q.Before != nil:
+		return o.reverse().seek(q.Before)
+	}
+	return "", nil
+}
+
+// OrderBy is the ORDER BY list to query with. Pages before a cursor are read
+// backwards from it; NewPage puts them back in order.
+func (q Query) OrderBy(o Order) string {
+	if q.Before != nil {
+		return o.reverse().SQL()
+	}
+	return o.SQL()
+}
+
+// Fetch is the LIMIT to query with: one row more than the page holds, to
+// learn whether another page follows.
+func (q Query) Fetch() int { return q.Limit + 1 }
+
+// Page is one page of a listing.
+type Page[T any] struct {
+	Items []T
+	Limit int
+	// Offset is set when the page was requested by offset.
+	Offset int
+	// Total counts every row matching the listing's filters.
+	Total int
+	// Next and Prev are cursors for the neighbouring pages, "" at either end.
+	Next, Prev string
+}
+
+// NewPage builds the page for q from the rows read with q's Seek, OrderBy and
+// Fetch. keys holds the sort key values of each row, in o's column order.
+func NewPage[T any](q Query, o Order, rows []T, keys [][]string) Page[T] {
+	more := len(rows) > q.Limit
+	if more {
+		rows, keys = rows[:q.Limit], keys[:q.Limit]
+	}
+
+	hasNext, hasPrev := more, q.After != nil || q.Offset > 0
+	if q.Before != nil {
+		slices.Reverse(rows)
+		slices.Reverse(keys)
+		hasNext, hasPrev = true, more
+	}
+
+	p := Page[T]{Items: rows, Limit: q.Limit, Offset: q.Offset}
+	if len(rows) > 0 {
+		if hasNext {
+			p.Next = EncodeCursor(o, keys[len(keys)-1])
+		}
+		if hasPrev {
+			p.Prev = EncodeCursor(o, keys[0])
+		}
+	}
+	return p
+}
+
+// Links renders the Link header for the page returned at u. The links keep
+// u's other query parameters. Pages requested by offset link by offset so
+// clients paging that way stay on it.
+func (p Page[T]) Links(u *url.URL) string {
+	link := func(rel string, set func(url.Values)) string {
+		q := u.Query()
+		q.Del("after")
+		q.Del("before")
+		q.Del("offset")
+		set(q)
+		target := url.URL{Path: u.Path, RawQuery: q.Encode()}
+		return "<" + target.String() + `>; rel="` + rel + `"`
+	}
+	setCursor := func(name, c string) func(url.Values) {
+		return func(q url.Values) { q.Set(name, c) }
+	}
+	setOffset := func(n int) func(url.Values) {
+		return func(q url.Values) {
+			if n > 0 {
+				q.Set("offset", strconv.Itoa(n))
+			}
+		}
+	}
+
+	links := []string{link("first", func(url.Values) {})}
+	if p.Offset > 0 {
+		if p.Prev != "" || len(p.Items) == 0 {
+			links = append(links, link("prev", setOffset(max(p.Offset-p.Limit, 0))))
+		}
+		if p.Next != "" {
+			links = append(links, link("next", setOffset(p.Offset+p.Limit)))
+		}
+		return strings.Join(links, ", ")
+	}
+	if p.Prev != "" {
+		links = append(links, link("prev", setCursor("before", p.Prev)))
+	}
+	if p.Next != "" {
+		links = append(links, link("next", setCursor("after", p.Next)))
+	}
+	return strings.Join(links, ", ")
+}
@@ -0,0 +1,130 @@
+package pagination_test
+
+import (
+	"errors"
+	"net/url"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/pagination"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var order = pagination.Order{{Column: "updated_at", Desc: true}, {Column: "id"}}
+
+func ptr[T any](v T) *T { return &v }
+
+var _ = Describe("Order", func() {
+	It("renders ORDER BY and the keyset predicate", func() {
+		Expect(order.SQL()).To(Equal("updated_at DESC, id ASC"))
+
+		q := pagination.Query{Limit: 10, After: []string{"t1", "b"}}
+		where, args := q.Seek(order)
+		Expect(where).To(Equal("((updated_at < ?) OR (updated_at = ? AND id > ?))"))
+		Expect(args).To(Equal([]any{"t1", "t1", "b"}))
+		Expect(q.OrderBy(order)).To(Equal("updated_at DESC, id ASC"))
+	})
+
+	It("reads pages before a cursor backwards", func() {
+		q := pagination.Query{Limit: 10, Before: []string{"t1", "b"}}
+		where, _ := q.Seek(order)
+		Expect(where).To(Equal("((updated_at > ?) OR (updated_at = ? AND id < ?))"))
+		Expect(q.OrderBy(order)).To(Equal("updated_at ASC, id DESC"))
+	})
+})
+
+var _ = Describe("Cursors", func() {
+	It("round-trips key values", func() {
+		c := pagination.EncodeCursor(order, []string{"2025-01-01T00:00:00Z", "id-1"})
+		Expect(c).NotTo(ContainSubstring("="))
+		Expect(pagination.DecodeCursor(order, c)).To(Equal([]string{"2025-01-01T00:00:00Z", "id-1"}))
+	})
+
+	It("rejects cursors of another order or garbage", func() {
+		other := pagination.Order{{Column: "name"}}
+		c := pagination.EncodeCursor(other, []string{"x"})
+		_, err := pagination.DecodeCursor(order, c)
+		Expect(err).To(MatchError(pagination.ErrInvalidCursor))
+
+		_, err = pagination.DecodeCursor(order, "%%%")
+		Expect(err).To(MatchError(pagination.ErrInvalidCursor))
+	})
+})
+
+var _ = Describe("ParseQuery", func() {
+	It("defaults and caps the limit", func() {
+		q, err := pagination.ParseQuery(pagination.Params{}, order, 50, 200)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(q.Limit).To(Equal(50))
+
+		q, err = pagination.ParseQuery(pagination.Params{Limit: ptr(1000)}, order, 50, 200)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(q.Limit).To(Equal(200))
+	})
+
+	It("decodes cursors and keeps offsets", func() {
+		c := pagination.EncodeCursor(order, []string{"t", "a"})
+		q, err := pagination.ParseQuery(pagination.Params{Before: &c}, order, 50, 200)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(q.Before).To(Equal([]string{"t", "a"}))
+
+		q, err = pagination.ParseQuery(pagination.Params{Offset: ptr(20)}, order, 50, 200)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(q.Offset).To(Equal(20))
+	})
+
+	It("reports bad or conflicting paging parameters as INVALID_PARAMETER", func() {
+		c := pagination.EncodeCursor(order, []string{"t", "a"})
+		for _, p := range []pagination.Params{
+			{After: ptr("nope")},
+			{After: &c, Before: &c},
+			{After: &c, Offset: ptr(5)},
+		} {
+			_, err := pagination.ParseQuery(p, order, 50, 200)
+			var apiErr *apierrors.Error
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.Code).To(Equal(apierrors.CodeInvalidParameter))
+		}
+	})
+})
+
+var _ = Describe("Page", func() {
+	keys := func(ids ...string) [][]string {
+		out := make([][]string, len(ids))
+		for i, id := range ids {
+			out[i] = []string{"t", id}
+		}
+		return out
+	}
+
+	It("trims the look-ahead row and links onwards", func() {
+		q := pagination.Query{Limit: 2}
+		p := pagination.NewPage(q, order, []string{"a", "b", "c"}, keys("a", "b", "c"))
+		Expect(p.Items).To(Equal([]string{"a", "b"}))
+		Expect(p.Prev).To(BeEmpty())
+		Expect(pagination.DecodeCursor(order, p.Next)).To(Equal([]string{"t", "b"}))
+
+		u, _ := url.Parse("/tasks?limit=2&status=DONE")
+		Expect(p.Links(u)).To(Equal(`</tasks?limit=2&status=DONE>; rel="first", ` +
+			`</tasks?after=` + p.Next + `&limit=2&status=DONE>; rel="next"`))
+	})
+
+	It("puts rows read before a cursor back in order", func() {
+		q := pagination.Query{Limit: 2, Before: []string{"t", "d"}}
+		// Read backwards from d: c, b, then the look-ahead a.
+		p := pagination.NewPage(q, order, []string{"c", "b", "a"}, keys("c", "b", "a"))
+		Expect(p.Items).To(Equal([]string{"b", "c"}))
+		Expect(pagination.DecodeCursor(order, p.Prev)).To(Equal([]string{"t", "b"}))
+		Expect(pagination.DecodeCursor(order, p.Next)).To(Equal([]string{"t", "c"}))
+	})
+
+	It("links by offset when the page was requested by offset", func() {
+		q := pagination.Query{Limit: 10, Offset: 15}
+		p := pagination.NewPage(q, order, make([]string, 11), keys(make([]string, 11)...))
+		u, _ := url.Parse("/projects?offset=15&limit=10")
+		Expect(p.Links(u)).To(Equal(`</projects?limit=10>; rel="first", ` +
+			`</projects?limit=10&offset=5>; rel="prev", ` +
+			`</projects?limit=10&offset=25>; rel="next"`))
+	})
+})
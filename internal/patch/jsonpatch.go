@@ -0,0 +1,331 @@
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Operation is one step of a JSON Patch document.
+type Operation struct {
+	Op    string
+	Path  string
+	From  string
+	Value any
+
+	path, from []string
+}
+
+// JSONPatch is a JSON Patch document (RFC 6902): operations applied in
+// order, all or nothing.
+type JSONPatch []Operation
+
+// ParseJSONPatch decodes a JSON Patch document and checks that every
+// operation is one RFC 6902 defines and carries the members it needs.
+func ParseJSONPatch(data []byte) (JSONPatch, error) {
+	var raw []map[string]json.RawMessage
+	if err := decode(data, &raw); err != nil {
+		return nil, err
+	}
+
+	out := make(JSONPatch, len(raw))
+	for i, members := range raw {
+		op := &out[i]
+		invalid := func(msg string) error { return &Error{Index: i, Path: op.Path, Msg: msg} }
+
+		if err := member(members, "op", &op.Op); err != nil {
+			return nil, invalid(`"op" must be a string`)
+		}
+		if err := member(members, "path", &op.Path); err != nil {
+			return nil, invalid(`"path" must be a string`)
+		}
+		switch op.Op {
+		case "add", "remove", "replace", "move", "copy", "test":
+		case "":
+			return nil, invalid(`"op" is required`)
+		default:
+			return nil, invalid("unknown op " + strconv.Quote(op.Op))
+		}
+		if _, ok := members["path"]; !ok {
+			return nil, invalid(`"path" is required`)
+		}
+		var err error
+		if op.path, err = parsePointer(op.Path); err != nil {
+			return nil, invalid(err.Error())
+		}
+
+		switch op.Op {
+		case "move", "copy":
+			if _, ok := members["from"]; !ok {
+				return nil, invalid(`"from" is required`)
+			}
+			if err := member(members, "from", &op.From); err != nil {
+				return nil, invalid(`"from" must be a string`)
+			}
+			if op.from, err = parsePointer(op.From); err != nil {
+				return nil, invalid(err.Error())
+			}
+			if op.Op == "move" && isPrefix(op.from, op.path) && len(op.from) < len(op.path) {
+				return nil, invalid("cannot move a value into one of its children")
+			}
+		case "add", "replace", "test":
+			// A null value is a value; only a missing member is an error.
+			v, ok := members["value"]
+			if !ok {
+				return nil, invalid(`"value" is required`)
+			}
+			if err := json.Unmarshal(v, &op.Value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return out, nil
+}
+
+// member decodes the member name of an operation into dest, leaving dest
+// alone when the member is missing.
+func member(members map[string]json.RawMessage, name string, dest *string) error {
+	v, ok := members[name]
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(v, dest)
+}
+
+// Apply returns doc with every operation applied in order, or the error of
+// the first operation that fails.
+func (p JSONPatch) Apply(doc any) (any, error) {
+	doc = clone(doc)
+	for i, op := range p {
+		var err error
+		if doc, err = op.apply(doc); err != nil {
+			if e, ok := err.(*Error); ok {
+				e.Index, e.Path = i, op.Path
+				return nil, e
+			}
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+func (op Operation) apply(doc any) (any, error) {
+	switch op.Op {
+	case "add":
+		return add(doc, op.path, clone(op.Value))
+	case "remove":
+		return remove(doc, op.path)
+	case "replace":
+		if _, err := get(doc, op.path); err != nil {
+			return nil, err
+		}
+		if len(op.path) == 0 {
+			return clone(op.Value), nil
+		}
+		return replace(doc, op.path, clone(op.Value))
+	case "move":
+		v, err := get(doc, op.from)
+		if err != nil {
+			return nil, err
+		}
+		if doc, err = remove(doc, op.from); err != nil {
+			return nil, err
+		}
+		return add(doc, op.path, v)
+	case "copy":
+		v, err := get(doc, op.from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, op.path, clone(v))
+	case "test":
+		v, err := get(doc, op.path)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(v, op.Value) {
+			return nil, &Error{Msg: "value differs from the tested one", Err: ErrTestFailed}
+		}
+		return doc, nil
+	}
+	return nil, &Error{Msg: "unknown op " + strconv.Quote(op.Op)}
+}
+
+// parsePointer splits a JSON Pointer (RFC 6901) into its unescaped reference
+// tokens. The empty pointer refers to the whole document.
+func parsePointer(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+	if ptr[0] != '/' {
+		return nil, errors.New("a JSON Pointer must be empty or start with /")
+	}
+	tokens := strings.Split(ptr[1:], "/")
+	for i, t := range tokens {
+		for j := 0; j < len(t); j++ {
+			if t[j] == '~' && (j+1 == len(t) || (t[j+1] != '0' && t[j+1] != '1')) {
+				return nil, errors.New("~ must be followed by 0 or 1 in a JSON Pointer")
+			}
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func isPrefix(prefix, path []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// get returns the value path refers to.
+func get(doc any, path []string) (any, error) {
+	for _, token := range path {
+		switch node := doc.(type) {
+		case map[string]any:
+			v, ok := node[token]
+			if !ok {
+				return nil, notFound()
+			}
+			doc = v
+		case []any:
+			i, err := index(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			doc = node[i]
+		default:
+			return nil, notFound()
+		}
+	}
+	return doc, nil
+}
+
+// add inserts value at path: it sets an object member, inserts into an
+// array before the index, or appends for the index -.
+func add(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return edit(doc, path, func(parent any, token string) (any, error) {
+		switch node := parent.(type) {
+		case map[string]any:
+			node[token] = value
+			return node, nil
+		case []any:
+			if token == "-" {
+				return append(node, value), nil
+			}
+			i, err := index(token, len(node))
+			if err != nil {
+				return nil, err
+			}
+			node = append(node, nil)
+			copy(node[i+1:], node[i:])
+			node[i] = value
+			return node, nil
+		}
+		return nil, notFound()
+	})
+}
+
+// remove deletes the value path refers to.
+func remove(doc any, path []string) (any, error) {
+	if len(path) == 0 {
+		return nil, &Error{Msg: "cannot remove the whole document"}
+	}
+	return edit(doc, path, func(parent any, token string) (any, error) {
+		switch node := parent.(type) {
+		case map[string]any:
+			if _, ok := node[token]; !ok {
+				return nil, notFound()
+			}
+			delete(node, token)
+			return node, nil
+		case []any:
+			i, err := index(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:i], node[i+1:]...), nil
+		}
+		return nil, notFound()
+	})
+}
+
+// replace sets the existing value path refers to.
+func replace(doc any, path []string, value any) (any, error) {
+	return edit(doc, path, func(parent any, token string) (any, error) {
+		switch node := parent.(type) {
+		case map[string]any:
+			node[token] = value
+			return node, nil
+		case []any:
+			i, err := index(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			node[i] = value
+			return node, nil
+		}
+		return nil, notFound()
+	})
+}
+
+// edit walks to the parent of the value path refers to and replaces the
+// parent with what fn returns for it and the last token. Arrays change
+// length, so every level stores the child it got back.
+func edit(doc any, path []string, fn func(parent any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return fn(doc, path[0])
+	}
+	switch node := doc.(type) {
+	case map[string]any:
+		child, ok := node[path[0]]
+		if !ok {
+			return nil, notFound()
+		}
+		child, err := edit(child, path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		node[path[0]] = child
+		return node, nil
+	case []any:
+		i, err := index(path[0], len(node)-1)
+		if err != nil {
+			return nil, err
+		}
+		child, err := edit(node[i], path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		node[i] = child
+		return node, nil
+	}
+	return nil, notFound()
+}
+
+// index parses an array index token, which must lie between 0 and max.
+// Tokens are plain decimal numbers without leading zeros.
+func index(token string, max int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') || strings.TrimLeft(token, "0123456789") != "" {
+		return 0, notFound()
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i > max {
+		return 0, notFound()
+	}
+	return i, nil
+}
+
+func notFound() *Error {
+	return &Error{Msg: "path does not exist"}
+}
@@ -0,0 +1,45 @@
+package patch
+
+// MergePatch is a JSON Merge Patch document (RFC 7396). Its members replace
+// the members of the same name in the target, null members remove them, and
+// objects merge recursively. Anything but an object replaces the target as a
+// whole.
+type MergePatch struct {
+	doc any
+}
+
+// ParseMergePatch decodes a JSON Merge Patch document.
+func ParseMergePatch(data []byte) (MergePatch, error) {
+	var doc any
+	if err := decode(data, &doc); err != nil {
+		return MergePatch{}, err
+	}
+	return MergePatch{doc: doc}, nil
+}
+
+// Apply returns doc with the merge patch applied. A merge patch always
+// applies.
+func (p MergePatch) Apply(doc any) (any, error) {
+	return merge(clone(doc), p.doc), nil
+}
+
+// merge follows the MergePatch pseudocode of RFC 7396, section 2. target is
+// modified in place.
+func merge(target, patch any) any {
+	members, ok := patch.(map[string]any)
+	if !ok {
+		return clone(patch)
+	}
+	obj, ok := target.(map[string]any)
+	if !ok {
+		obj = map[string]any{}
+	}
+	for name, value := range members {
+		if value == nil {
+			delete(obj, name)
+			continue
+		}
+		obj[name] = merge(obj[name], value)
+	}
+	return obj
+}
@@ -0,0 +1,128 @@
+// Package patch applies the documents clients send to change part of a
+// resource: JSON Merge Patch (RFC 7396) and JSON Patch (RFC 6902). Both work
+// on decoded JSON, the values encoding/json produces when decoding into an
+// any: map[string]any, []any, string, float64, bool and nil.
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// The media types of the two formats.
+const (
+	MergePatchType = "application/merge-patch+json"
+	JSONPatchType  = "application/json-patch+json"
+)
+
+// ErrUnsupportedMediaType is returned by Parse for media types other than
+// MergePatchType and JSONPatchType.
+var ErrUnsupportedMediaType = errors.New("unsupported patch media type")
+
+// ErrTestFailed is the cause of the Error a JSON Patch test operation
+// reports when the document does not hold the tested value.
+var ErrTestFailed = errors.New("test failed")
+
+// Error is a patch that cannot be applied to a document.
+type Error struct {
+	// Index is the position of the failing JSON Patch operation, from 0.
+	Index int
+	// Path is the JSON Pointer the operation failed on.
+	Path string
+	Msg  string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("operation %d on %q: %s", e.Index, e.Path, e.Msg)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Patch is a parsed patch document of either format.
+type Patch interface {
+	// Apply returns doc with the patch applied. doc itself is never
+	// modified, so a patch that fails leaves nothing half applied.
+	Apply(doc any) (any, error)
+}
+
+// Parse decodes a patch document of the given media type. A body that is
+// not JSON is reported as a *json.SyntaxError, one that is JSON but no patch
+// as an *Error.
+func Parse(mediaType string, data []byte) (Patch, error) {
+	switch mediaType {
+	case MergePatchType:
+		return ParseMergePatch(data)
+	case JSONPatchType:
+		return ParseJSONPatch(data)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedMediaType, mediaType)
+	}
+}
+
+// decode reads exactly one JSON value from data.
+func decode(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return &json.SyntaxError{Offset: dec.InputOffset()}
+	}
+	return nil
+}
+
+// clone deep copies a decoded JSON value.
+func clone(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			out[k] = clone(e)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, e := range v {
+			out[i] = clone(e)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// equal reports whether two decoded JSON values are the same JSON value.
+// Member order does not matter; numbers compare by value.
+func equal(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, av := range a {
+			bv, ok := b[k]
+			if !ok || !equal(av, bv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
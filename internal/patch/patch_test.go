@@ -0,0 +1,157 @@
+package patch_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"full-stack-assesment/internal/patch"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func doc(src string) any {
+	var v any
+	ExpectWithOffset(1, json.Unmarshal([]byte(src), &v)).To(Succeed(), src)
+	return v
+}
+
+// apply parses p as mediaType and applies it to target, both given as JSON.
+func apply(mediaType, target, p string) (any, error) {
+	parsed, err := patch.Parse(mediaType, []byte(p))
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), p)
+	return parsed.Apply(doc(target))
+}
+
+var _ = Describe("MergePatch", func() {
+	// The test cases of RFC 7396, Appendix A.
+	DescribeTable("applies the examples of RFC 7396",
+		func(target, p, want string) {
+			got, err := apply(patch.MergePatchType, target, p)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(json.Marshal(got)).To(MatchJSON(want))
+		},
+		Entry(nil, `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`),
+		Entry(nil, `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`),
+		Entry(nil, `{"a":"b"}`, `{"a":null}`, `{}`),
+		Entry(nil, `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`),
+		Entry(nil, `{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`),
+		Entry(nil, `{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`),
+		Entry(nil, `{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`),
+		Entry(nil, `{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`),
+		Entry(nil, `["a","b"]`, `["c","d"]`, `["c","d"]`),
+		Entry(nil, `{"a":"b"}`, `["c"]`, `["c"]`),
+		Entry(nil, `{"a":"foo"}`, `null`, `null`),
+		Entry(nil, `{"a":"foo"}`, `"bar"`, `"bar"`),
+		Entry(nil, `{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`),
+		Entry(nil, `[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`),
+		Entry(nil, `{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`),
+	)
+
+	It("leaves the target untouched", func() {
+		target := doc(`{"a":{"b":"c"}}`)
+		p, err := patch.ParseMergePatch([]byte(`{"a":{"b":null}}`))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = p.Apply(target)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(target).To(Equal(doc(`{"a":{"b":"c"}}`)))
+	})
+
+	It("reports bodies that are not JSON as syntax errors", func() {
+		for _, body := range []string{`{"a":`, `{} {}`, ``} {
+			_, err := patch.ParseMergePatch([]byte(body))
+			Expect(err).To(HaveOccurred(), body)
+			var pe *patch.Error
+			Expect(errors.As(err, &pe)).To(BeFalse(), body)
+		}
+	})
+})
+
+var _ = Describe("JSONPatch", func() {
+	// The examples of RFC 6902, Appendix A.
+	DescribeTable("applies the examples of RFC 6902",
+		func(target, p, want string) {
+			got, err := apply(patch.JSONPatchType, target, p)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(got).To(Equal(doc(want)))
+		},
+		Entry("adds an object member", `{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`, `{"baz":"qux","foo":"bar"}`),
+		Entry("adds an array element", `{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`, `{"foo":["bar","qux","baz"]}`),
+		Entry("removes an object member", `{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`),
+		Entry("removes an array element", `{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`, `{"foo":["bar","baz"]}`),
+		Entry("replaces a value", `{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`, `{"baz":"boo","foo":"bar"}`),
+		Entry("moves a value", `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			`[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			`{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`),
+		Entry("moves an array element", `{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`, `{"foo":["all","cows","eat","grass"]}`),
+		Entry("tests a value", `{"baz":"qux","foo":["a",2,"c"]}`,
+			`[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`,
+			`{"baz":"qux","foo":["a",2,"c"]}`),
+		Entry("adds a nested member object", `{"foo":"bar"}`, `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`, `{"foo":"bar","child":{"grandchild":{}}}`),
+		Entry("ignores unrecognized members", `{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux","xyz":123}]`, `{"foo":"bar","baz":"qux"}`),
+		Entry("follows ~1 escape ordering", `{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":10}]`, `{"/":9,"~1":10}`),
+		Entry("adds an array value", `{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`, `{"foo":["bar",["abc","def"]]}`),
+		Entry("replaces the whole document", `{"foo":"bar"}`, `[{"op":"replace","path":"","value":{"baz":1}}]`, `{"baz":1}`),
+		Entry("copies a value", `{"foo":{"bar":1}}`, `[{"op":"copy","from":"/foo","path":"/baz"},{"op":"replace","path":"/baz/bar","value":2}]`, `{"foo":{"bar":1},"baz":{"bar":2}}`),
+		Entry("adds null", `{}`, `[{"op":"add","path":"/a","value":null}]`, `{"a":null}`),
+	)
+
+	DescribeTable("fails the failing examples of RFC 6902",
+		func(target, p string, testFailed bool) {
+			_, err := apply(patch.JSONPatchType, target, p)
+			var pe *patch.Error
+			Expect(errors.As(err, &pe)).To(BeTrue(), "%v", err)
+			Expect(errors.Is(err, patch.ErrTestFailed)).To(Equal(testFailed))
+		},
+		Entry("adds to a nonexistent target", `{"foo":"bar"}`, `[{"op":"add","path":"/baz/bat","value":"qux"}]`, false),
+		Entry("tests a differing value", `{"baz":"qux"}`, `[{"op":"test","path":"/baz","value":"bar"}]`, true),
+		Entry("compares strings and numbers by type", `{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":"10"}]`, true),
+		Entry("removes a missing member", `{"a":1}`, `[{"op":"remove","path":"/b"}]`, false),
+		Entry("replaces a missing member", `{"a":1}`, `[{"op":"replace","path":"/b","value":2}]`, false),
+		Entry("inserts past the end of an array", `{"a":[1]}`, `[{"op":"add","path":"/a/2","value":2}]`, false),
+		Entry("uses a leading zero index", `{"a":[1,2]}`, `[{"op":"remove","path":"/a/01"}]`, false),
+		Entry("tests a missing member", `{}`, `[{"op":"test","path":"/a","value":null}]`, false),
+	)
+
+	It("applies all operations or none", func() {
+		target := doc(`{"title":"a","status":"TODO"}`)
+		p, err := patch.ParseJSONPatch([]byte(`[
+			{"op":"replace","path":"/title","value":"b"},
+			{"op":"test","path":"/status","value":"DONE"}
+		]`))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = p.Apply(target)
+		var pe *patch.Error
+		Expect(errors.As(err, &pe)).To(BeTrue())
+		Expect(pe.Index).To(Equal(1))
+		Expect(pe.Path).To(Equal("/status"))
+		Expect(target).To(Equal(doc(`{"title":"a","status":"TODO"}`)))
+	})
+
+	DescribeTable("rejects operations that are not RFC 6902",
+		func(p, msg string) {
+			_, err := patch.ParseJSONPatch([]byte(p))
+			var pe *patch.Error
+			Expect(errors.As(err, &pe)).To(BeTrue(), "%v", err)
+			Expect(pe.Msg).To(Equal(msg))
+		},
+		Entry("without op", `[{"path":"/a"}]`, `"op" is required`),
+		Entry("with an unknown op", `[{"op":"merge","path":"/a"}]`, `unknown op "merge"`),
+		Entry("without path", `[{"op":"remove"}]`, `"path" is required`),
+		Entry("with a path that is no pointer", `[{"op":"remove","path":"a"}]`, "a JSON Pointer must be empty or start with /"),
+		Entry("with a bad escape", `[{"op":"remove","path":"/a~2"}]`, "~ must be followed by 0 or 1 in a JSON Pointer"),
+		Entry("without value", `[{"op":"add","path":"/a"}]`, `"value" is required`),
+		Entry("without from", `[{"op":"copy","path":"/a"}]`, `"from" is required`),
+		Entry("moving into a child", `[{"op":"move","from":"/a","path":"/a/b"}]`, "cannot move a value into one of its children"),
+		Entry("with a non-string op", `[{"op":1,"path":"/a"}]`, `"op" must be a string`),
+	)
+
+	It("reports bodies that are not a JSON array as syntax or type errors", func() {
+		for _, body := range []string{`[{"op":`, `{"op":"add"}`, `[] []`} {
+			_, err := patch.ParseJSONPatch([]byte(body))
+			Expect(err).To(HaveOccurred(), body)
+			var pe *patch.Error
+			Expect(errors.As(err, &pe)).To(BeFalse(), body)
+		}
+	})
+})
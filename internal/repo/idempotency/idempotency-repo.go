@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/store"
+)
+
+// Record is a stored Idempotency-Key. Status is 0 while the request that
+// claimed the key is still running; afterwards Status, Header and Body are
+// the response it produced.
+type Record struct {
+	Key         string
+	Fingerprint string
+	Status      int
+	Header      http.Header
+	Body        []byte
+}
+
+type SQLiteIdempotencyRepo struct {
+	db *store.DB
+}
+
+func NewSQLiteIdempotencyRepo(db *store.DB) *SQLiteIdempotencyRepo {
+	return &SQLiteIdempotencyRepo{db: db}
+}
+
+// claimAttempts bounds how often Claim retries when the record it lost to
+// is released before it could be read. A key that keeps changing hands is
+// reported as still in progress.
+const claimAttempts = 3
+
+// Claim reserves key for the request identified by fingerprint until
+// expiresAt, taking over records that expired by now. When an unexpired
+// record holds the key already, it reports false and returns that record.
+// The reservation is a single statement on the one write connection, so of
+// two concurrent requests with the same key exactly one claims it.
+func (r *SQLiteIdempotencyRepo) Claim(ctx context.Context, key, fingerprint string, now, expiresAt time.Time) (Record, bool, error) {
+	const claim = `
+		INSERT INTO idempotency_keys (key, fingerprint, created_at, expires_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (key) DO UPDATE SET
+			fingerprint = excluded.fingerprint,
+			status = NULL,
+			header = NULL,
+			body = NULL,
+			created_at = excluded.created_at,
+			expires_at = excluded.expires_at
+		WHERE idempotency_keys.expires_at <= excluded.created_at
+	`
+	const get = `
+		SELECT fingerprint, status, header, body
+		FROM idempotency_keys
+		WHERE key = ?
+	`
+	for range claimAttempts {
+		res, err := r.db.ExecContext(ctx, claim, key, fingerprint, helpers.FormatTime(now), helpers.FormatTime(expiresAt))
+		if err != nil {
+			return Record{}, false, err
+		}
+		if aff, _ := res.RowsAffected(); aff == 1 {
+			return Record{Key: key, Fingerprint: fingerprint}, true, nil
+		}
+
+		rec := Record{Key: key}
+		var (
+			status sql.NullInt64
+			header sql.NullString
+		)
+		err = r.db.QueryRowContext(ctx, get, key).Scan(&rec.Fingerprint, &status, &header, &rec.Body)
+		if err == sql.ErrNoRows {
+			// Released by its owner in between; try to claim it again.
+			continue
+		}
+		if err != nil {
+			return Record{}, false, err
+		}
+		rec.Status = int(status.Int64)
+		if header.Valid {
+			if err := json.Unmarshal([]byte(header.String), &rec.Header); err != nil {
+				return Record{}, false, err
+			}
+		}
+		return rec, false, nil
+	}
+	return Record{Key: key, Fingerprint: fingerprint}, false, nil
+}
+
+// Complete stores the response of the request that claimed key.
+func (r *SQLiteIdempotencyRepo) Complete(ctx context.Context, key string, status int, header http.Header, body []byte) error {
+	const q = `UPDATE idempotency_keys SET status = ?, header = ?, body = ? WHERE key = ?`
+
+	encoded, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, q, status, string(encoded), body, key)
+	return err
+}
+
+// Release forgets key, so the next request carrying it runs again.
+func (r *SQLiteIdempotencyRepo) Release(ctx context.Context, key string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE key = ?`, key)
+	return err
+}
+
+// DeleteExpired removes the records that expired by now and returns how many
+// there were.
+func (r *SQLiteIdempotencyRepo) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= ?`, helpers.FormatTime(now))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
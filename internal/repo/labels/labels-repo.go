@@ -0,0 +1,154 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/store"
+)
+
+type LabelsRepository interface {
+	Create(ctx context.Context, l scheme.Label) error
+	Get(ctx context.Context, projectID, id string) (scheme.Label, error)
+	List(ctx context.Context, projectID string) ([]scheme.Label, error)
+	Update(ctx context.Context, l scheme.Label) error
+	Delete(ctx context.Context, projectID, id string, now time.Time) error
+}
+
+type SQLiteLabelsRepo struct {
+	db *store.DB
+}
+
+func NewSQLiteLabelsRepo(db *store.DB) *SQLiteLabelsRepo {
+	return &SQLiteLabelsRepo{db: db}
+}
+
+func (r *SQLiteLabelsRepo) Create(ctx context.Context, l scheme.Label) error {
+	const q = `
+		INSERT INTO labels (id, project_id, name, color, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	_, err := r.db.ExecContext(ctx, q, l.Id.String(), l.ProjectId.String(), l.Name, l.Color,
+		helpers.FormatTime(l.CreatedAt), helpers.FormatTime(l.UpdatedAt))
+	return err
+}
+
+// Get returns a label with the number of tasks carrying it.
+func (r *SQLiteLabelsRepo) Get(ctx context.Context, projectID, id string) (scheme.Label, error) {
+	const q = `
+		SELECT l.id, l.project_id, l.name, l.color, l.created_at, l.updated_at, COUNT(tl.task_id)
+		FROM labels l LEFT JOIN task_labels tl ON tl.label_id = l.id
+		WHERE l.id = ? AND l.project_id = ?
+		GROUP BY l.id
+	`
+	l, err := scanLabel(r.db.QueryRowContext(ctx, q, id, projectID))
+	if err == sql.ErrNoRows {
+		return scheme.Label{}, apierrors.ErrLabelNotFound
+	}
+	return l, err
+}
+
+// List returns the labels of a project by name, with the number of tasks
+// carrying each.
+func (r *SQLiteLabelsRepo) List(ctx context.Context, projectID string) ([]scheme.Label, error) {
+	const q = `
+		SELECT l.id, l.project_id, l.name, l.color, l.created_at, l.updated_at, COUNT(tl.task_id)
+		FROM labels l LEFT JOIN task_labels tl ON tl.label_id = l.id
+		WHERE l.project_id = ?
+		GROUP BY l.id
+		ORDER BY l.name
+	`
+	rows, err := r.db.QueryContext(ctx, q, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []scheme.Label{}
+	for rows.Next() {
+		l, err := scanLabel(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, l)
+	}
+	return out, rows.Err()
+}
+
+// Update writes the name, color and update time of l and bumps the version of
+// every task carrying it, since the tasks show the label.
+func (r *SQLiteLabelsRepo) Update(ctx context.Context, l scheme.Label) error {
+	const q = `
+		UPDATE labels
+		SET name = ?, color = ?, updated_at = ?
+		WHERE id = ? AND project_id = ?
+	`
+	res, err := r.db.ExecContext(ctx, q, l.Name, l.Color, helpers.FormatTime(l.UpdatedAt), l.Id.String(), l.ProjectId.String())
+	if err != nil {
+		return err
+	}
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return apierrors.ErrLabelNotFound
+	}
+	return r.touchTasks(ctx, l.Id.String(), l.UpdatedAt)
+}
+
+// Delete removes a label, which takes it off every task carrying it and bumps
+// their versions.
+func (r *SQLiteLabelsRepo) Delete(ctx context.Context, projectID, id string, now time.Time) error {
+	const q = `DELETE FROM labels WHERE id = ? AND project_id = ?`
+
+	if err := r.touchTasks(ctx, id, now); err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, q, id, projectID)
+	if err != nil {
+		return err
+	}
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return apierrors.ErrLabelNotFound
+	}
+	return nil
+}
+
+// InTx runs fn in a transaction; see store.DB.InTx.
+func (r *SQLiteLabelsRepo) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.db.InTx(ctx, fn)
+}
+
+// touchTasks sets the update time of the tasks carrying a label and bumps
+// their versions.
+func (r *SQLiteLabelsRepo) touchTasks(ctx context.Context, labelID string, now time.Time) error {
+	const q = `
+		UPDATE tasks
+		SET updated_at = ?, version = version + 1
+		WHERE id IN (SELECT task_id FROM task_labels WHERE label_id = ?)
+	`
+	_, err := r.db.ExecContext(ctx, q, helpers.FormatTime(now), labelID)
+	return err
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanLabel(row scanner) (scheme.Label, error) {
+	var idStr, projStr, name, color, created, updated string
+	var count int
+	if err := row.Scan(&idStr, &projStr, &name, &color, &created, &updated, &count); err != nil {
+		return scheme.Label{}, err
+	}
+	return scheme.Label{
+		Id:        helpers.MustUUID(idStr),
+		ProjectId: helpers.MustUUID(projStr),
+		Name:      name,
+		Color:     color,
+		TaskCount: count,
+		CreatedAt: helpers.ParseTimeOrNow(created),
+		UpdatedAt: helpers.ParseTimeOrNow(updated),
+	}, nil
+}
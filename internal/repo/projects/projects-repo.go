@@ -6,7 +6,11 @@ import (
 	"errors"
 	"full-stack-assesment/internal/apierrors"
 	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/pagination"
 	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/store"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
@@ -19,64 +23,197 @@ var (
 
 type ProjectsRepository interface {
 	Create(ctx context.Context, t scheme.Project) error
-	UpdateStatus(ctx context.Context, id string, completed bool) error
+	Update(ctx context.Context, t scheme.Project, version int) (int, error)
 	Get(ctx context.Context, id string) (scheme.Project, error)
-	List(ctx context.Context) ([]scheme.Project, error)
-	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, order pagination.Order, q pagination.Query) (pagination.Page[scheme.Project], error)
+	Delete(ctx context.Context, id string, version int) error
 }
 
 type SQLiteProjectsRepo struct {
-	db *sql.DB
+	db *store.DB
 }
 
-func NewSQLiteProjectsRepo(db *sql.DB) *SQLiteProjectsRepo {
+func NewSQLiteProjectsRepo(db *store.DB) *SQLiteProjectsRepo {
 	return &SQLiteProjectsRepo{db: db}
 }
 
 func (r *SQLiteProjectsRepo) Create(ctx context.Context, project scheme.Project) error {
 	const q = `
-		INSERT INTO projects (id, name, created_at, updated_at)
-		VALUES (?, ?, ?, ?)
+		INSERT INTO projects (id, name, created_at, updated_at, version)
+		VALUES (?, ?, ?, ?, ?)
 	`
-	if _, err := r.db.ExecContext(ctx, q, project.Id.String(), project.Name, project.CreatedAt, project.UpdatedAt); err != nil {
+	if _, err := r.db.ExecContext(ctx, q, project.Id.String(), project.Name, helpers.FormatTime(project.CreatedAt), helpers.FormatTime(project.UpdatedAt), project.Version); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r *SQLiteProjectsRepo) List(ctx context.Context) ([]scheme.Project, error) {
+func (r *SQLiteProjectsRepo) Get(ctx context.Context, id string) (scheme.Project, error) {
 	const q = `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, created_at, updated_at, version
 		FROM projects
-		ORDER BY updated_at DESC, name ASC
+		WHERE id = ?
 	`
-	rows, err := r.db.QueryContext(ctx, q)
+	var idStr, name, created, updated string
+	var version int
+	if err := r.db.QueryRowContext(ctx, q, id).Scan(&idStr, &name, &created, &updated, &version); err != nil {
+		if err == sql.ErrNoRows {
+			return scheme.Project{}, apierrors.ErrProjectNotFound
+		}
+		return scheme.Project{}, err
+	}
+	return scheme.Project{
+		Id:        helpers.MustUUID(idStr),
+		Name:      name,
+		CreatedAt: helpers.ParseTimeOrNow(created),
+		UpdatedAt: helpers.ParseTimeOrNow(updated),
+		Version:   version,
+	}, nil
+}
+
+// Update writes the name and updated_at of project and bumps its version,
+// returning the new one. With a version other than 0 it only updates the
+// project while it is still at that version.
+func (r *SQLiteProjectsRepo) Update(ctx context.Context, project scheme.Project, version int) (int, error) {
+	q := `
+		UPDATE projects
+		SET name = ?, updated_at = ?, version = version + 1
+		WHERE id = ?`
+	args := []any{project.Name, helpers.FormatTime(project.UpdatedAt), project.Id.String()}
+	if version != 0 {
+		q += ` AND version = ?`
+		args = append(args, version)
+	}
+
+	// RETURNING makes this a query, which outside a transaction would run
+	// on the read-only pool.
+	var updated int
+	err := r.db.InTx(ctx, func(ctx context.Context) error {
+		return r.db.QueryRowContext(ctx, q+` RETURNING version`, args...).Scan(&updated)
+	})
+	if err == sql.ErrNoRows {
+		return 0, r.missed(ctx, project.Id.String(), version)
+	}
+	return updated, err
+}
+
+// Delete removes the project; its tasks go with it through the ON DELETE
+// CASCADE foreign key, which relies on foreign_keys being enabled per connection.
+// With a version other than 0 it only removes the project while it is still
+// at that version.
+func (r *SQLiteProjectsRepo) Delete(ctx context.Context, id string, version int) error {
+	q := `DELETE FROM projects WHERE id = ?`
+	args := []any{id}
+	if version != 0 {
+		q += ` AND version = ?`
+		args = append(args, version)
+	}
+
+	res, err := r.db.ExecContext(ctx, q, args...)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	aff, _ := res.RowsAffected()
+	if aff == 0 {
+		return r.missed(ctx, id, version)
+	}
+	return nil
+}
+
+// TouchDependents bumps the versions of the tasks in other projects that
+// block, or are blocked by, a task of the project. Deleting the project drops
+// these dependencies along with its tasks.
+func (r *SQLiteProjectsRepo) TouchDependents(ctx context.Context, id string, now time.Time) error {
+	const q = `
+		UPDATE tasks
+		SET updated_at = ?, version = version + 1
+		WHERE project_id <> ? AND id IN (
+			SELECT d.blocked_id FROM task_dependencies d JOIN tasks t ON t.id = d.blocker_id WHERE t.project_id = ?
+			UNION
+			SELECT d.blocker_id FROM task_dependencies d JOIN tasks t ON t.id = d.blocked_id WHERE t.project_id = ?
+		);
+	`
+	_, err := r.db.ExecContext(ctx, q, helpers.FormatTime(now), id, id, id)
+	return err
+}
+
+// InTx runs fn in a transaction; see store.DB.InTx.
+func (r *SQLiteProjectsRepo) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.db.InTx(ctx, fn)
+}
+
+// missed explains a write that matched no row: the project is gone, or it
+// has moved past the version the write was conditional on.
+func (r *SQLiteProjectsRepo) missed(ctx context.Context, id string, version int) error {
+	if version == 0 {
+		return apierrors.ErrProjectNotFound
+	}
+	if err := r.EnsureProjectExists(ctx, id); err != nil {
+		return err
+	}
+	return apierrors.ErrPreconditionFailed
+}
+
+// List returns the page q of all projects, sorted by order.
+func (r *SQLiteProjectsRepo) List(ctx context.Context, order pagination.Order, q pagination.Query) (pagination.Page[scheme.Project], error) {
+	where, args := "", []any{}
+	if seek, seekArgs := q.Seek(order); seek != "" {
+		where, args = "WHERE "+seek, seekArgs
+	}
+	stmt := `
+		SELECT id, name, created_at, updated_at, version, ` + strings.Join(order.Columns(), ", ") + `
+		FROM projects
+		` + where + `
+		ORDER BY ` + q.OrderBy(order) + `
+		LIMIT ? OFFSET ?
+	`
+	args = append(args, q.Fetch(), q.Offset)
+
+	rows, err := r.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return pagination.Page[scheme.Project]{}, err
 	}
 	defer func() { _ = rows.Close() }()
 
-	projects := make([]scheme.Project, 0, 16)
+	projects := make([]scheme.Project, 0, q.Fetch())
+	keys := make([][]string, 0, q.Fetch())
 	for rows.Next() {
 		var idStr, name, created, updated string
-		if err := rows.Scan(&idStr, &name, &created, &updated); err != nil {
-			return nil, err
+		var version int
+		key := make([]string, len(order))
+		dest := []any{&idStr, &name, &created, &updated, &version}
+		for i := range key {
+			dest = append(dest, &key[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return pagination.Page[scheme.Project]{}, err
 		}
 		u, parseErr := uuid.Parse(idStr)
 		if parseErr != nil {
-			return nil, err
+			return pagination.Page[scheme.Project]{}, parseErr
 		}
 		projects = append(projects, scheme.Project{
 			Id:        types.UUID(u),
 			Name:      name,
 			CreatedAt: helpers.ParseTimeOrNow(created),
 			UpdatedAt: helpers.ParseTimeOrNow(updated),
+			Version:   version,
 		})
+		keys = append(keys, key)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return pagination.Page[scheme.Project]{}, err
+	}
+	return pagination.NewPage(q, order, projects, keys), nil
+}
+
+// Count returns the number of projects.
+func (r *SQLiteProjectsRepo) Count(ctx context.Context) (int, error) {
+	var n int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM projects`).Scan(&n); err != nil {
+		return 0, err
 	}
-	return projects, nil
+	return n, nil
 }
 
 func (r *SQLiteProjectsRepo) EnsureProjectExists(ctx context.Context, projectID string) error {
@@ -90,3 +227,32 @@ func (r *SQLiteProjectsRepo) EnsureProjectExists(ctx context.Context, projectID
 	}
 	return nil
 }
+
+// Names returns the names of the projects in ids, keyed by ID. IDs of
+// projects that do not exist are left out.
+func (r *SQLiteProjectsRepo) Names(ctx context.Context, ids []string) (map[string]string, error) {
+	out := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	marks := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT id, name FROM projects WHERE id IN (`+marks+`)`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, err
+		}
+		out[id] = name
+	}
+	return out, rows.Err()
+}
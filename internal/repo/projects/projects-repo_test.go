@@ -0,0 +1,51 @@
+package repo_test
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"full-stack-assesment/internal/migrate"
+	repo "full-stack-assesment/internal/repo/projects"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/store"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SQLiteProjectsRepo", func() {
+	var (
+		ctx      context.Context
+		projects *repo.SQLiteProjectsRepo
+	)
+
+	// A file database keeps separate read and write pools, unlike the
+	// in-memory one the API suite runs on.
+	BeforeEach(func() {
+		ctx = context.Background()
+		db, err := store.Open(ctx, store.Config{DSN: filepath.Join(GinkgoT().TempDir(), "todo.db")})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(db.Close)
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
+		projects = repo.NewSQLiteProjectsRepo(db)
+	})
+
+	It("updates a project on a file database", func() {
+		now := time.Now().UTC()
+		project := scheme.Project{Id: types.UUID(uuid.New()), Name: "Before", CreatedAt: now, UpdatedAt: now, Version: 1}
+		Expect(projects.Create(ctx, project)).To(Succeed())
+
+		project.Name = "After"
+		version, err := projects.Update(ctx, project, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal(2))
+
+		got, err := projects.Get(ctx, project.Id.String())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Name).To(Equal("After"))
+		Expect(got.Version).To(Equal(2))
+	})
+})
@@ -0,0 +1,13 @@
+package repo_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestProjectsRepo(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Projects Repo Suite")
+}
@@ -5,10 +5,15 @@ import (
 	"database/sql"
 	"errors"
 	"strings"
+	"time"
 
 	"full-stack-assesment/internal/apierrors"
 	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/pagination"
 	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/store"
+
+	"github.com/oapi-codegen/runtime/types"
 )
 
 var (
@@ -19,23 +24,30 @@ var (
 type TaskRepository interface {
 	Create(ctx context.Context, t scheme.Task) error
 	Get(ctx context.Context, taskUUID string) (scheme.Task, error)
-	List(ctx context.Context) ([]scheme.Task, error)
-	Update(ctx context.Context, t scheme.Task) error
+	List(ctx context.Context, where []string, args []any, order pagination.Order, q pagination.Query) (pagination.Page[scheme.Task], error)
+	Update(ctx context.Context, t scheme.Task, version int) error
 	Delete(ctx context.Context, taskUUID string) error
 }
 
+// StatusCount is the number of tasks in one status within one project.
+type StatusCount struct {
+	ProjectID string
+	Status    string
+	Count     int
+}
+
 type SQLiteTaskRepo struct {
-	db *sql.DB
+	db *store.DB
 }
 
-func NewSQLiteTaskRepo(db *sql.DB) *SQLiteTaskRepo {
+func NewSQLiteTaskRepo(db *store.DB) *SQLiteTaskRepo {
 	return &SQLiteTaskRepo{db: db}
 }
 
 func (r *SQLiteTaskRepo) Create(ctx context.Context, t scheme.Task) error {
 	const q = `
-		INSERT INTO tasks (id, project_id, title, description, status, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?);
+		INSERT INTO tasks (id, project_id, parent_id, title, description, status, created_at, updated_at, version)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
 	`
 	var desc string
 	if t.Description != nil {
@@ -43,7 +55,7 @@ func (r *SQLiteTaskRepo) Create(ctx context.Context, t scheme.Task) error {
 	}
 	taskUUID := t.Id.String()
 	projectUUID := t.ProjectId.String()
-	if _, err := r.db.ExecContext(ctx, q, taskUUID, projectUUID, t.Title, desc, t.Status, t.CreatedAt, t.UpdatedAt); err != nil {
+	if _, err := r.db.ExecContext(ctx, q, taskUUID, projectUUID, parentArg(t), t.Title, desc, t.Status, helpers.FormatTime(t.CreatedAt), helpers.FormatTime(t.UpdatedAt), t.Version); err != nil {
 		return err
 	}
 	return nil
@@ -51,15 +63,17 @@ func (r *SQLiteTaskRepo) Create(ctx context.Context, t scheme.Task) error {
 
 func (r *SQLiteTaskRepo) Get(ctx context.Context, taskUUID string, projectUUID string) (*scheme.Task, error) {
 	const q = `
-		SELECT id, project_id, title, description, status, created_at, updated_at
+		SELECT id, project_id, parent_id, title, description, status, created_at, updated_at, version
 		FROM tasks
 		WHERE id = ? AND project_id = ?;
 	`
 	var idStr, projStr, title, desc, status, created, updated string
+	var parent sql.NullString
+	var version int
 	err := r.db.QueryRowContext(ctx, q, taskUUID, projectUUID).
-		Scan(&idStr, &projStr, &title, &desc, &status, &created, &updated)
+		Scan(&idStr, &projStr, &parent, &title, &desc, &status, &created, &updated, &version)
 	if err == sql.ErrNoRows {
-		return nil, sql.ErrNoRows
+		return nil, apierrors.ErrTaskNotFound
 	}
 	if err != nil {
 		return nil, err
@@ -68,6 +82,7 @@ func (r *SQLiteTaskRepo) Get(ctx context.Context, taskUUID string, projectUUID s
 	out := scheme.Task{
 		Id:        helpers.MustUUID(idStr),
 		ProjectId: helpers.MustUUID(projStr),
+		ParentId:  parentID(parent),
 		Title:     title,
 		Description: func() *string {
 			if desc == "" {
@@ -78,71 +93,242 @@ func (r *SQLiteTaskRepo) Get(ctx context.Context, taskUUID string, projectUUID s
 		Status:    scheme.TaskStatus(status),
 		CreatedAt: helpers.ParseTimeOrNow(created),
 		UpdatedAt: helpers.ParseTimeOrNow(updated),
+		Version:   version,
 	}
 	return &out, nil
 }
 
-func (r *SQLiteTaskRepo) List(ctx context.Context, offset, limit int, where []string, args []any) ([]scheme.Task, error) {
+// List returns the page q of the tasks matching where, sorted by order.
+func (r *SQLiteTaskRepo) List(ctx context.Context, where []string, args []any, order pagination.Order, q pagination.Query) (pagination.Page[scheme.Task], error) {
+	if seek, seekArgs := q.Seek(order); seek != "" {
+		where = append(where, seek)
+		args = append(args, seekArgs...)
+	}
 	stmt := `
-		SELECT id, project_id, title, description, status, created_at, updated_at
+		SELECT id, project_id, parent_id, title, description, status, created_at, updated_at, version, ` + strings.Join(order.Columns(), ", ") + `
 		FROM tasks
-		WHERE ` + strings.Join(where, " AND ") + `
-		ORDER BY updated_at DESC
+		` + whereClause(where) + `
+		ORDER BY ` + q.OrderBy(order) + `
 		LIMIT ? OFFSET ?;
 	`
+	args = append(args, q.Fetch(), q.Offset)
 
 	rows, err := r.db.QueryContext(ctx, stmt, args...)
 	if err != nil {
-		return []scheme.Task{}, err
+		return pagination.Page[scheme.Task]{}, err
 	}
 	defer rows.Close()
 
-	out := make([]scheme.Task, 0, limit)
+	out := make([]scheme.Task, 0, q.Fetch())
+	keys := make([][]string, 0, q.Fetch())
 	for rows.Next() {
 		var idStr, projStr, title, desc, status, created, updated string
-		if err := rows.Scan(&idStr, &projStr, &title, &desc, &status, &created, &updated); err != nil {
-			return []scheme.Task{}, err
+		var parent sql.NullString
+		var version int
+		key := make([]string, len(order))
+		dest := []any{&idStr, &projStr, &parent, &title, &desc, &status, &created, &updated, &version}
+		for i := range key {
+			dest = append(dest, &key[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return pagination.Page[scheme.Task]{}, err
 		}
 		var descPtr *string
 		if strings.TrimSpace(desc) != "" {
 			cp := desc
 			descPtr = &cp
 		}
-		// If time permitted, I would apply pagination on the UI. I have incorporated fields (limit and offset) for the very same reason
-		// P.S. to ensure functionality, suite_test covers this case so no worries
 		out = append(out, scheme.Task{
 			Id:          helpers.MustUUID(idStr),
 			ProjectId:   helpers.MustUUID(projStr),
+			ParentId:    parentID(parent),
 			Title:       title,
 			Description: descPtr,
 			Status:      scheme.TaskStatus(status),
 			CreatedAt:   helpers.ParseTimeOrNow(created),
 			UpdatedAt:   helpers.ParseTimeOrNow(updated),
+			Version:     version,
 		})
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return pagination.Page[scheme.Task]{}, err
+	}
+	return pagination.NewPage(q, order, out, keys), nil
+}
+
+// Count returns how many tasks match where.
+func (r *SQLiteTaskRepo) Count(ctx context.Context, where []string, args []any) (int, error) {
+	stmt := `SELECT COUNT(*) FROM tasks ` + whereClause(where) + `;`
+
+	var n int
+	if err := r.db.QueryRowContext(ctx, stmt, args...).Scan(&n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// IDs returns the IDs of the tasks matching where, oldest first.
+func (r *SQLiteTaskRepo) IDs(ctx context.Context, where []string, args []any) ([]string, error) {
+	stmt := `SELECT id FROM tasks ` + whereClause(where) + ` ORDER BY created_at, id;`
+
+	rows, err := r.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+// SearchHit is a task matched by Search. Title and Snippet are the matched
+// title and an excerpt of the description, with every matched token between
+// HighlightStart and HighlightEnd.
+type SearchHit struct {
+	Task    scheme.Task
+	Score   float64
+	Title   string
+	Snippet string
+}
+
+// Markers Search puts around matched tokens. They are control characters so
+// callers can escape the text for their output format before swapping them
+// for real markup.
+const (
+	HighlightStart = "\x01"
+	HighlightEnd   = "\x02"
+)
+
+// Search returns the tasks matching the FTS5 expression match, best first,
+// limited to one project unless projectID is empty. Title matches weigh ten
+// times as much as description matches.
+func (r *SQLiteTaskRepo) Search(ctx context.Context, match, projectID string, limit, offset int) ([]SearchHit, error) {
+	where, args := searchFilter(match, projectID)
+	stmt := `
+		SELECT t.id, t.project_id, t.parent_id, t.title, t.description, t.status, t.created_at, t.updated_at, t.version,
+			-bm25(tasks_fts, 0, 0, 10.0, 1.0) AS score,
+			highlight(tasks_fts, 2, char(1), char(2)),
+			snippet(tasks_fts, 3, char(1), char(2), '…', 16)
+		FROM tasks_fts
+		JOIN tasks t ON t.id = tasks_fts.task_id
+		WHERE ` + where + `
+		ORDER BY score DESC, t.updated_at DESC, t.id
+		LIMIT ? OFFSET ?;
+	`
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]SearchHit, 0, limit)
+	for rows.Next() {
+		var (
+			idStr, projStr, title, desc, status, created, updated string
+			parent                                                sql.NullString
+			version                                               int
+			hit                                                   SearchHit
+		)
+		if err := rows.Scan(&idStr, &projStr, &parent, &title, &desc, &status, &created, &updated, &version, &hit.Score, &hit.Title, &hit.Snippet); err != nil {
+			return nil, err
+		}
+		var descPtr *string
+		if strings.TrimSpace(desc) != "" {
+			descPtr = &desc
+		}
+		hit.Task = scheme.Task{
+			Id:          helpers.MustUUID(idStr),
+			ProjectId:   helpers.MustUUID(projStr),
+			ParentId:    parentID(parent),
+			Title:       title,
+			Description: descPtr,
+			Status:      scheme.TaskStatus(status),
+			CreatedAt:   helpers.ParseTimeOrNow(created),
+			UpdatedAt:   helpers.ParseTimeOrNow(updated),
+			Version:     version,
+		}
+		out = append(out, hit)
+	}
+	return out, rows.Err()
+}
+
+// SearchCount returns how many tasks Search can return for match and projectID.
+func (r *SQLiteTaskRepo) SearchCount(ctx context.Context, match, projectID string) (int, error) {
+	where, args := searchFilter(match, projectID)
+	stmt := `SELECT COUNT(*) FROM tasks_fts WHERE ` + where + `;`
+
+	var n int
+	if err := r.db.QueryRowContext(ctx, stmt, args...).Scan(&n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func searchFilter(match, projectID string) (string, []any) {
+	if projectID == "" {
+		return "tasks_fts MATCH ?", []any{match}
+	}
+	return "tasks_fts MATCH ? AND tasks_fts.project_id = ?", []any{match, projectID}
+}
+
+// whereClause joins conditions into a WHERE clause, or returns "" when there
+// are none.
+func whereClause(where []string) string {
+	if len(where) == 0 {
+		return ""
 	}
-	return out, nil
+	return "WHERE " + strings.Join(where, " AND ")
 }
 
-func (r *SQLiteTaskRepo) Delete(ctx context.Context, taskUUID string, projectUUID string) error {
-	const q = `DELETE FROM tasks WHERE id = ? AND project_id = ?;`
+// Delete removes a task. With a version other than 0 it only removes the task
+// while it is still at that version.
+func (r *SQLiteTaskRepo) Delete(ctx context.Context, taskUUID string, projectUUID string, version int) error {
+	q := `DELETE FROM tasks WHERE id = ? AND project_id = ?`
+	args := []any{taskUUID, projectUUID}
+	if version != 0 {
+		q += ` AND version = ?`
+		args = append(args, version)
+	}
 
-	res, err := r.db.ExecContext(ctx, q, taskUUID, projectUUID)
+	res, err := r.db.ExecContext(ctx, q, args...)
 	if err != nil {
 		return err
 	}
 	aff, _ := res.RowsAffected()
 	if aff == 0 {
-		return apierrors.ErrorTaskTitleNotFound
+		return r.missed(ctx, taskUUID, projectUUID, version)
 	}
 	return nil
 }
 
-func (r *SQLiteTaskRepo) Update(ctx context.Context, args []any, set []string) error {
+// Update writes the parent, title, description, status and update time of t
+// and bumps its version. With a version other than 0 it only updates the task
+// while it is still at that version.
+func (r *SQLiteTaskRepo) Update(ctx context.Context, t scheme.Task, version int) error {
 	stmt := `
 		UPDATE tasks
-		SET ` + strings.Join(set, ", ") + `
-		WHERE id = ? AND project_id = ?;
-	`
+		SET parent_id = ?, title = ?, description = ?, status = ?, updated_at = ?, version = version + 1
+		WHERE id = ? AND project_id = ?`
+	var desc string
+	if t.Description != nil {
+		desc = *t.Description
+	}
+	taskUUID, projectUUID := t.Id.String(), t.ProjectId.String()
+	args := []any{parentArg(t), t.Title, desc, t.Status, helpers.FormatTime(t.UpdatedAt), taskUUID, projectUUID}
+	if version != 0 {
+		stmt += ` AND version = ?`
+		args = append(args, version)
+	}
 
 	res, err := r.db.ExecContext(ctx, stmt, args...)
 	if err != nil {
@@ -150,7 +336,447 @@ func (r *SQLiteTaskRepo) Update(ctx context.Context, args []any, set []string) e
 	}
 	aff, _ := res.RowsAffected()
 	if aff == 0 {
-		return apierrors.ErrorTaskTitleNotFound
+		return r.missed(ctx, taskUUID, projectUUID, version)
 	}
 	return nil
 }
+
+// IsAncestor reports whether the task ancestorUUID is taskUUID itself or one
+// of the tasks above it.
+func (r *SQLiteTaskRepo) IsAncestor(ctx context.Context, ancestorUUID string, taskUUID string) (bool, error) {
+	const q = `
+		WITH RECURSIVE up(id, parent_id) AS (
+			SELECT id, parent_id FROM tasks WHERE id = ?
+			UNION
+			SELECT t.id, t.parent_id FROM tasks t JOIN up ON t.id = up.parent_id
+		)
+		SELECT EXISTS (SELECT 1 FROM up WHERE id = ?);
+	`
+	var found bool
+	if err := r.db.QueryRowContext(ctx, q, taskUUID, ancestorUUID).Scan(&found); err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// TouchAncestors sets the update time of the tasks above a task, at every
+// depth, and bumps their versions, since their progress counts it.
+func (r *SQLiteTaskRepo) TouchAncestors(ctx context.Context, taskUUID string, now time.Time) error {
+	const q = `
+		WITH RECURSIVE up(id) AS (
+			SELECT parent_id FROM tasks WHERE id = ?
+			UNION
+			SELECT t.parent_id FROM tasks t JOIN up ON t.id = up.id
+		)
+		UPDATE tasks
+		SET updated_at = ?, version = version + 1
+		WHERE id IN (SELECT id FROM up);
+	`
+	_, err := r.db.ExecContext(ctx, q, taskUUID, helpers.FormatTime(now))
+	return err
+}
+
+// Promote moves the subtasks of a task under parentUUID, or to the top level
+// when it is nil, bumping their versions.
+func (r *SQLiteTaskRepo) Promote(ctx context.Context, taskUUID string, parentUUID *types.UUID, now time.Time) error {
+	const q = `
+		UPDATE tasks
+		SET parent_id = ?, updated_at = ?, version = version + 1
+		WHERE parent_id = ?;
+	`
+	var parent any
+	if parentUUID != nil {
+		parent = parentUUID.String()
+	}
+	_, err := r.db.ExecContext(ctx, q, parent, helpers.FormatTime(now), taskUUID)
+	return err
+}
+
+// Touch sets the update time of the tasks in ids and bumps their versions,
+// for changes kept outside the tasks table that still show on the task.
+func (r *SQLiteTaskRepo) Touch(ctx context.Context, now time.Time, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	marks := make([]string, len(ids))
+	args := []any{helpers.FormatTime(now)}
+	for i, id := range ids {
+		marks[i] = "?"
+		args = append(args, id)
+	}
+	stmt := `UPDATE tasks SET updated_at = ?, version = version + 1 WHERE id IN (` + strings.Join(marks, ", ") + `);`
+	_, err := r.db.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+// Progress counts the subtasks at every depth of each task in ids, and how
+// many of them are done. Tasks without subtasks are left out.
+func (r *SQLiteTaskRepo) Progress(ctx context.Context, ids []string) (map[string]scheme.TaskProgress, error) {
+	out := map[string]scheme.TaskProgress{}
+	if len(ids) == 0 {
+		return out, nil
+	}
+	marks := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		marks[i], args[i] = "?", id
+	}
+	stmt := `
+		WITH RECURSIVE below(root, id, status) AS (
+			SELECT parent_id, id, status FROM tasks WHERE parent_id IN (` + strings.Join(marks, ", ") + `)
+			UNION ALL
+			SELECT below.root, t.id, t.status FROM tasks t JOIN below ON t.parent_id = below.id
+		)
+		SELECT root, SUM(status = 'DONE'), COUNT(*) FROM below GROUP BY root;
+	`
+
+	rows, err := r.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			root string
+			p    scheme.TaskProgress
+		)
+		if err := rows.Scan(&root, &p.Done, &p.Total); err != nil {
+			return nil, err
+		}
+		out[root] = p
+	}
+	return out, rows.Err()
+}
+
+// Descendants returns the subtasks at every depth of a task, oldest first.
+func (r *SQLiteTaskRepo) Descendants(ctx context.Context, taskUUID string) ([]scheme.Task, error) {
+	const q = `
+		WITH RECURSIVE below(id) AS (
+			SELECT id FROM tasks WHERE parent_id = ?
+			UNION ALL
+			SELECT t.id FROM tasks t JOIN below ON t.parent_id = below.id
+		)
+		SELECT t.id, t.project_id, t.parent_id, t.title, t.description, t.status, t.created_at, t.updated_at, t.version
+		FROM tasks t JOIN below ON t.id = below.id
+		ORDER BY t.created_at, t.id;
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, taskUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []scheme.Task
+	for rows.Next() {
+		var idStr, projStr, title, desc, status, created, updated string
+		var parent sql.NullString
+		var version int
+		if err := rows.Scan(&idStr, &projStr, &parent, &title, &desc, &status, &created, &updated, &version); err != nil {
+			return nil, err
+		}
+		out = append(out, scheme.Task{
+			Id:          helpers.MustUUID(idStr),
+			ProjectId:   helpers.MustUUID(projStr),
+			ParentId:    parentID(parent),
+			Title:       title,
+			Description: nonEmpty(desc),
+			Status:      scheme.TaskStatus(status),
+			CreatedAt:   helpers.ParseTimeOrNow(created),
+			UpdatedAt:   helpers.ParseTimeOrNow(updated),
+			Version:     version,
+		})
+	}
+	return out, rows.Err()
+}
+
+// Exists reports whether a task exists, in any project.
+func (r *SQLiteTaskRepo) Exists(ctx context.Context, taskUUID string) (bool, error) {
+	var found bool
+	if err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM tasks WHERE id = ?)`, taskUUID).Scan(&found); err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// AddDependency records that blockerUUID blocks blockedUUID and bumps the
+// versions of both tasks. Recording it again changes nothing.
+func (r *SQLiteTaskRepo) AddDependency(ctx context.Context, blockerUUID string, blockedUUID string, now time.Time) error {
+	const q = `
+		INSERT OR IGNORE INTO task_dependencies (blocker_id, blocked_id, created_at)
+		VALUES (?, ?, ?);
+	`
+	res, err := r.db.ExecContext(ctx, q, blockerUUID, blockedUUID, helpers.FormatTime(now))
+	if err != nil {
+		return err
+	}
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return nil
+	}
+	return r.Touch(ctx, now, blockerUUID, blockedUUID)
+}
+
+// RemoveDependency deletes the dependency of blockedUUID on blockerUUID and
+// bumps the versions of both tasks.
+func (r *SQLiteTaskRepo) RemoveDependency(ctx context.Context, blockerUUID string, blockedUUID string, now time.Time) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM task_dependencies WHERE blocker_id = ? AND blocked_id = ?`, blockerUUID, blockedUUID)
+	if err != nil {
+		return err
+	}
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return apierrors.ErrDependencyNotFound
+	}
+	return r.Touch(ctx, now, blockerUUID, blockedUUID)
+}
+
+// TouchDependents bumps the versions of the tasks that block, or are blocked
+// by, a task or any of its subtasks, leaving out those subtasks themselves.
+// Deleting the task drops these dependencies.
+func (r *SQLiteTaskRepo) TouchDependents(ctx context.Context, taskUUID string, now time.Time) error {
+	const q = `
+		WITH RECURSIVE gone(id) AS (
+			SELECT ?
+			UNION ALL
+			SELECT t.id FROM tasks t JOIN gone ON t.parent_id = gone.id
+		)
+		UPDATE tasks
+		SET updated_at = ?, version = version + 1
+		WHERE id NOT IN gone AND id IN (
+			SELECT blocked_id FROM task_dependencies WHERE blocker_id IN gone
+			UNION
+			SELECT blocker_id FROM task_dependencies WHERE blocked_id IN gone
+		);
+	`
+	_, err := r.db.ExecContext(ctx, q, taskUUID, helpers.FormatTime(now))
+	return err
+}
+
+// Blocks reports whether the task fromUUID is toUUID itself or blocks it,
+// directly or through other tasks.
+func (r *SQLiteTaskRepo) Blocks(ctx context.Context, fromUUID string, toUUID string) (bool, error) {
+	const q = `
+		WITH RECURSIVE down(id) AS (
+			SELECT ?
+			UNION
+			SELECT d.blocked_id FROM task_dependencies d JOIN down ON d.blocker_id = down.id
+		)
+		SELECT EXISTS (SELECT 1 FROM down WHERE id = ?);
+	`
+	var found bool
+	if err := r.db.QueryRowContext(ctx, q, fromUUID, toUUID).Scan(&found); err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// Dependencies returns, for each task in ids that has any, the tasks blocking
+// it and the tasks it blocks, oldest dependency first.
+func (r *SQLiteTaskRepo) Dependencies(ctx context.Context, ids []string) (blockedBy, blocks map[string][]types.UUID, err error) {
+	blockedBy, blocks = map[string][]types.UUID{}, map[string][]types.UUID{}
+	if len(ids) == 0 {
+		return blockedBy, blocks, nil
+	}
+	marks := make([]string, len(ids))
+	args := make([]any, 0, 2*len(ids))
+	for i, id := range ids {
+		marks[i] = "?"
+		args = append(args, id)
+	}
+	args = append(args, args...)
+	in := "(" + strings.Join(marks, ", ") + ")"
+	stmt := `
+		SELECT blocker_id, blocked_id
+		FROM task_dependencies
+		WHERE blocker_id IN ` + in + ` OR blocked_id IN ` + in + `
+		ORDER BY created_at, blocker_id, blocked_id;
+	`
+
+	rows, err := r.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var blocker, blocked string
+		if err := rows.Scan(&blocker, &blocked); err != nil {
+			return nil, nil, err
+		}
+		blockedBy[blocked] = append(blockedBy[blocked], helpers.MustUUID(blocker))
+		blocks[blocker] = append(blocks[blocker], helpers.MustUUID(blocked))
+	}
+	return blockedBy, blocks, rows.Err()
+}
+
+// UnfinishedBlockers returns the IDs of the tasks blocking taskUUID that are
+// not DONE.
+func (r *SQLiteTaskRepo) UnfinishedBlockers(ctx context.Context, taskUUID string) ([]string, error) {
+	const q = `
+		SELECT t.id
+		FROM task_dependencies d JOIN tasks t ON t.id = d.blocker_id
+		WHERE d.blocked_id = ? AND t.status <> 'DONE'
+		ORDER BY d.created_at, t.id;
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, taskUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		out = append(out, id)
+	}
+	return out, rows.Err()
+}
+
+// HasLabel reports whether labelUUID is a label of the project projectUUID.
+func (r *SQLiteTaskRepo) HasLabel(ctx context.Context, projectUUID string, labelUUID string) (bool, error) {
+	var found bool
+	if err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM labels WHERE id = ? AND project_id = ?)`, labelUUID, projectUUID).Scan(&found); err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+// AddLabel puts a label on a task and bumps the task's version. Putting it on
+// again changes nothing.
+func (r *SQLiteTaskRepo) AddLabel(ctx context.Context, taskUUID string, labelUUID string, now time.Time) error {
+	const q = `
+		INSERT OR IGNORE INTO task_labels (task_id, label_id, created_at)
+		VALUES (?, ?, ?);
+	`
+	res, err := r.db.ExecContext(ctx, q, taskUUID, labelUUID, helpers.FormatTime(now))
+	if err != nil {
+		return err
+	}
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return nil
+	}
+	return r.Touch(ctx, now, taskUUID)
+}
+
+// RemoveLabel takes a label off a task and bumps the task's version.
+func (r *SQLiteTaskRepo) RemoveLabel(ctx context.Context, taskUUID string, labelUUID string, now time.Time) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM task_labels WHERE task_id = ? AND label_id = ?`, taskUUID, labelUUID)
+	if err != nil {
+		return err
+	}
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return apierrors.ErrLabelNotFound.WithMessage("the task does not carry that label")
+	}
+	return r.Touch(ctx, now, taskUUID)
+}
+
+// Labels returns the labels on each task in ids that has any, by name.
+func (r *SQLiteTaskRepo) Labels(ctx context.Context, ids []string) (map[string][]scheme.TaskLabel, error) {
+	out := map[string][]scheme.TaskLabel{}
+	if len(ids) == 0 {
+		return out, nil
+	}
+	marks := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		marks[i], args[i] = "?", id
+	}
+	stmt := `
+		SELECT tl.task_id, l.id, l.name, l.color
+		FROM task_labels tl JOIN labels l ON l.id = tl.label_id
+		WHERE tl.task_id IN (` + strings.Join(marks, ", ") + `)
+		ORDER BY l.name;
+	`
+
+	rows, err := r.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskID, labelID string
+		var l scheme.TaskLabel
+		if err := rows.Scan(&taskID, &labelID, &l.Name, &l.Color); err != nil {
+			return nil, err
+		}
+		l.Id = helpers.MustUUID(labelID)
+		out[taskID] = append(out[taskID], l)
+	}
+	return out, rows.Err()
+}
+
+// parentArg is the parent_id column value of t.
+func parentArg(t scheme.Task) any {
+	if t.ParentId == nil {
+		return nil
+	}
+	return t.ParentId.String()
+}
+
+// parentID reads the parent_id column.
+func parentID(parent sql.NullString) *types.UUID {
+	if !parent.Valid {
+		return nil
+	}
+	id := helpers.MustUUID(parent.String)
+	return &id
+}
+
+func nonEmpty(s string) *string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	return &s
+}
+
+// InTx runs fn in a transaction; see store.DB.InTx.
+func (r *SQLiteTaskRepo) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.db.InTx(ctx, fn)
+}
+
+// missed explains a write that matched no row: the task is gone, or it has
+// moved past the version the write was conditional on.
+func (r *SQLiteTaskRepo) missed(ctx context.Context, taskUUID string, projectUUID string, version int) error {
+	if version == 0 {
+		return apierrors.ErrTaskNotFound
+	}
+	var one int
+	err := r.db.QueryRowContext(ctx, `SELECT 1 FROM tasks WHERE id = ? AND project_id = ?`, taskUUID, projectUUID).Scan(&one)
+	if err == sql.ErrNoRows {
+		return apierrors.ErrTaskNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return apierrors.ErrPreconditionFailed
+}
+
+// CountByStatus counts tasks per project and status.
+func (r *SQLiteTaskRepo) CountByStatus(ctx context.Context) ([]StatusCount, error) {
+	const q = `
+		SELECT project_id, status, COUNT(*)
+		FROM tasks
+		GROUP BY project_id, status;
+	`
+
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []StatusCount
+	for rows.Next() {
+		var c StatusCount
+		if err := rows.Scan(&c.ProjectID, &c.Status, &c.Count); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,150 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/store"
+)
+
+type ViewsRepository interface {
+	Create(ctx context.Context, v scheme.View) error
+	Get(ctx context.Context, projectID, id string) (scheme.View, error)
+	List(ctx context.Context, projectID string) ([]scheme.View, error)
+	Update(ctx context.Context, v scheme.View) error
+	Delete(ctx context.Context, projectID, id string) error
+}
+
+type SQLiteViewsRepo struct {
+	db *store.DB
+}
+
+func NewSQLiteViewsRepo(db *store.DB) *SQLiteViewsRepo {
+	return &SQLiteViewsRepo{db: db}
+}
+
+func (r *SQLiteViewsRepo) Create(ctx context.Context, v scheme.View) error {
+	const q = `
+		INSERT INTO task_views (id, project_id, name, filter, sort, columns, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	columns, err := json.Marshal(v.Columns)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, q, v.Id.String(), v.ProjectId.String(), v.Name, deref(v.Filter), deref(v.Sort), string(columns),
+		helpers.FormatTime(v.CreatedAt), helpers.FormatTime(v.UpdatedAt))
+	return err
+}
+
+func (r *SQLiteViewsRepo) Get(ctx context.Context, projectID, id string) (scheme.View, error) {
+	const q = `
+		SELECT id, project_id, name, filter, sort, columns, created_at, updated_at
+		FROM task_views
+		WHERE id = ? AND project_id = ?
+	`
+	v, err := scanView(r.db.QueryRowContext(ctx, q, id, projectID))
+	if err == sql.ErrNoRows {
+		return scheme.View{}, apierrors.ErrViewNotFound
+	}
+	return v, err
+}
+
+// List returns the views of a project by name.
+func (r *SQLiteViewsRepo) List(ctx context.Context, projectID string) ([]scheme.View, error) {
+	const q = `
+		SELECT id, project_id, name, filter, sort, columns, created_at, updated_at
+		FROM task_views
+		WHERE project_id = ?
+		ORDER BY name
+	`
+	rows, err := r.db.QueryContext(ctx, q, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []scheme.View{}
+	for rows.Next() {
+		v, err := scanView(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, rows.Err()
+}
+
+func (r *SQLiteViewsRepo) Update(ctx context.Context, v scheme.View) error {
+	const q = `
+		UPDATE task_views
+		SET name = ?, filter = ?, sort = ?, columns = ?, updated_at = ?
+		WHERE id = ? AND project_id = ?
+	`
+	columns, err := json.Marshal(v.Columns)
+	if err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, q, v.Name, deref(v.Filter), deref(v.Sort), string(columns), helpers.FormatTime(v.UpdatedAt),
+		v.Id.String(), v.ProjectId.String())
+	if err != nil {
+		return err
+	}
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return apierrors.ErrViewNotFound
+	}
+	return nil
+}
+
+func (r *SQLiteViewsRepo) Delete(ctx context.Context, projectID, id string) error {
+	const q = `DELETE FROM task_views WHERE id = ? AND project_id = ?`
+
+	res, err := r.db.ExecContext(ctx, q, id, projectID)
+	if err != nil {
+		return err
+	}
+	if aff, _ := res.RowsAffected(); aff == 0 {
+		return apierrors.ErrViewNotFound
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanView(row scanner) (scheme.View, error) {
+	var idStr, projStr, name, filter, sort, columns, created, updated string
+	if err := row.Scan(&idStr, &projStr, &name, &filter, &sort, &columns, &created, &updated); err != nil {
+		return scheme.View{}, err
+	}
+	v := scheme.View{
+		Id:        helpers.MustUUID(idStr),
+		ProjectId: helpers.MustUUID(projStr),
+		Name:      name,
+		CreatedAt: helpers.ParseTimeOrNow(created),
+		UpdatedAt: helpers.ParseTimeOrNow(updated),
+	}
+	if filter != "" {
+		v.Filter = &filter
+	}
+	if sort != "" {
+		v.Sort = &sort
+	}
+	if err := json.Unmarshal([]byte(columns), &v.Columns); err != nil {
+		return scheme.View{}, err
+	}
+	return v, nil
+}
+
+// deref stores unset optional text as the empty string.
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
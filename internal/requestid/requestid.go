@@ -0,0 +1,156 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+)
+
+const (
+	// Header carries the request ID in both directions.
+	Header = "X-Request-ID"
+	// TraceparentHeader is the W3C Trace Context header.
+	TraceparentHeader = "traceparent"
+
+	maxRequestIDLength = 128
+)
+
+// IDs identifies a request in logs, error bodies and response headers.
+type IDs struct {
+	// RequestID is the caller's X-Request-ID, or the trace ID when none was sent.
+	RequestID string
+	// TraceID is the 32 hex digit W3C trace ID, taken from an incoming
+	// traceparent or generated.
+	TraceID string
+	// SpanID is the 16 hex digit ID of the server side of this request.
+	SpanID string
+	// ParentID is the span ID of the caller, if it sent a traceparent.
+	ParentID string
+	// Flags are the trace flags of an incoming traceparent, passed on as
+	// sent, or "01" (sampled) for a new trace.
+	Flags string
+}
+
+// Traceparent formats ids as the traceparent header sent back to the caller.
+func (ids IDs) Traceparent() string {
+	return "00-" + ids.TraceID + "-" + ids.SpanID + "-" + ids.Flags
+}
+
+type ctxKey struct{}
+
+func NewContext(ctx context.Context, ids IDs) context.Context {
+	return context.WithValue(ctx, ctxKey{}, ids)
+}
+
+// FromContext returns the IDs stored by NewContext.
+func FromContext(ctx context.Context) (IDs, bool) {
+	ids, ok := ctx.Value(ctxKey{}).(IDs)
+	return ids, ok
+}
+
+// TraceID returns the trace ID of the request in ctx, or "".
+func TraceID(ctx context.Context) string {
+	ids, _ := FromContext(ctx)
+	return ids.TraceID
+}
+
+// New derives the IDs of a request from its X-Request-ID and traceparent
+// headers. Invalid or missing values are replaced with fresh ones.
+func New(requestID, traceparent string) IDs {
+	ids := IDs{SpanID: randomHex(8), Flags: "01"}
+	if traceID, parentID, flags, ok := ParseTraceparent(traceparent); ok {
+		ids.TraceID, ids.ParentID, ids.Flags = traceID, parentID, flags
+	} else {
+		ids.TraceID = randomHex(16)
+	}
+
+	if validRequestID(requestID) {
+		ids.RequestID = requestID
+	} else {
+		ids.RequestID = ids.TraceID
+	}
+	return ids
+}
+
+// ParseTraceparent parses a version 00 traceparent header. All-zero trace and
+// parent IDs are invalid per the spec.
+func ParseTraceparent(s string) (traceID, parentID, flags string, ok bool) {
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 4 || parts[0] != "00" {
+		return "", "", "", false
+	}
+	traceID, parentID, flags = parts[1], parts[2], parts[3]
+	if !isHex(traceID, 32) || !isHex(parentID, 16) || !isHex(flags, 2) {
+		return "", "", "", false
+	}
+	if strings.Trim(traceID, "0") == "" || strings.Trim(parentID, "0") == "" {
+		return "", "", "", false
+	}
+	return traceID, parentID, flags, true
+}
+
+// validRequestID accepts short printable ASCII IDs so callers cannot inject
+// log lines or oversized headers.
+func validRequestID(s string) bool {
+	if s == "" || len(s) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x21 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func isHex(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// logHandler adds the request and trace IDs found in the record's context.
+type logHandler struct {
+	slog.Handler
+}
+
+// NewLogHandler wraps h so every record logged with a request context carries
+// request_id, trace_id and span_id.
+func NewLogHandler(h slog.Handler) slog.Handler {
+	return logHandler{h}
+}
+
+func (h logHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ids, ok := FromContext(ctx); ok {
+		r = r.Clone()
+		r.AddAttrs(
+			slog.String("request_id", ids.RequestID),
+			slog.String("trace_id", ids.TraceID),
+			slog.String("span_id", ids.SpanID),
+		)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return logHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h logHandler) WithGroup(name string) slog.Handler {
+	return logHandler{h.Handler.WithGroup(name)}
+}
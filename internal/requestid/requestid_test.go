@@ -0,0 +1,81 @@
+package requestid_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"full-stack-assesment/internal/requestid"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const parent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+var _ = Describe("IDs", func() {
+	It("continues an incoming trace with a new span", func() {
+		ids := requestid.New("", parent)
+		Expect(ids.TraceID).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+		Expect(ids.ParentID).To(Equal("00f067aa0ba902b7"))
+		Expect(ids.SpanID).To(HaveLen(16))
+		Expect(ids.SpanID).NotTo(Equal(ids.ParentID))
+		Expect(ids.RequestID).To(Equal(ids.TraceID))
+		Expect(ids.Traceparent()).To(Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-" + ids.SpanID + "-01"))
+	})
+
+	It("passes the caller's trace flags on", func() {
+		ids := requestid.New("", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+		Expect(ids.Flags).To(Equal("00"))
+		Expect(ids.Traceparent()).To(HaveSuffix("-" + ids.SpanID + "-00"))
+
+		ids = requestid.New("", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-03")
+		Expect(ids.Traceparent()).To(HaveSuffix("-03"))
+	})
+
+	It("keeps a caller supplied request ID", func() {
+		ids := requestid.New("checkout-42", "")
+		Expect(ids.RequestID).To(Equal("checkout-42"))
+		Expect(ids.TraceID).To(HaveLen(32))
+	})
+
+	DescribeTable("rejects invalid input",
+		func(requestID, traceparent string) {
+			ids := requestid.New(requestID, traceparent)
+			Expect(ids.TraceID).To(HaveLen(32))
+			Expect(ids.TraceID).NotTo(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+			Expect(ids.RequestID).To(Equal(ids.TraceID))
+		},
+		Entry("unknown version", "", "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"),
+		Entry("upper case hex", "", "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01"),
+		Entry("zero trace ID", "", "00-00000000000000000000000000000000-00f067aa0ba902b7-01"),
+		Entry("short parent ID", "", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa-01"),
+		Entry("request ID with spaces", "a b", "garbage"),
+		Entry("request ID with a newline", "a\nb", ""),
+	)
+})
+
+var _ = Describe("NewLogHandler", func() {
+	It("adds the request IDs from the context to every record", func() {
+		var buf bytes.Buffer
+		log := slog.New(requestid.NewLogHandler(slog.NewJSONHandler(&buf, nil))).With("component", "test")
+
+		ids := requestid.New("req-1", parent)
+		log.InfoContext(requestid.NewContext(context.Background(), ids), "hello")
+
+		var rec map[string]any
+		Expect(json.Unmarshal(buf.Bytes(), &rec)).To(Succeed())
+		Expect(rec).To(HaveKeyWithValue("request_id", "req-1"))
+		Expect(rec).To(HaveKeyWithValue("trace_id", ids.TraceID))
+		Expect(rec).To(HaveKeyWithValue("span_id", ids.SpanID))
+		Expect(rec).To(HaveKeyWithValue("component", "test"))
+	})
+
+	It("leaves records without a request context alone", func() {
+		var buf bytes.Buffer
+		log := slog.New(requestid.NewLogHandler(slog.NewJSONHandler(&buf, nil)))
+		log.Info("startup")
+		Expect(buf.String()).NotTo(ContainSubstring("request_id"))
+	})
+})
@@ -9,12 +9,57 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for BatchSubRequestMethod.
+const (
+	BatchDelete BatchSubRequestMethod = "DELETE"
+	BatchGet    BatchSubRequestMethod = "GET"
+	BatchPatch  BatchSubRequestMethod = "PATCH"
+	BatchPost   BatchSubRequestMethod = "POST"
+	BatchPut    BatchSubRequestMethod = "PUT"
+)
+
+// Defines values for BulkMode.
+const (
+	BulkModeAtomic     BulkMode = "atomic"
+	BulkModeBestEffort BulkMode = "bestEffort"
+)
+
+// Defines values for BulkTaskOp.
+const (
+	BulkOpCreate    BulkTaskOp = "create"
+	BulkOpDelete    BulkTaskOp = "delete"
+	BulkOpSetStatus BulkTaskOp = "setStatus"
+	BulkOpUpdate    BulkTaskOp = "update"
+)
+
+// Defines values for JsonPatchOperationOp.
+const (
+	JsonPatchAdd     JsonPatchOperationOp = "add"
+	JsonPatchCopy    JsonPatchOperationOp = "copy"
+	JsonPatchMove    JsonPatchOperationOp = "move"
+	JsonPatchRemove  JsonPatchOperationOp = "remove"
+	JsonPatchReplace JsonPatchOperationOp = "replace"
+	JsonPatchTest    JsonPatchOperationOp = "test"
+)
+
+// Defines values for LabelMatch.
+const (
+	LabelMatchAll LabelMatch = "all"
+	LabelMatchAny LabelMatch = "any"
+)
+
 // Defines values for Status.
 const (
 	Ok        Status = "ok"
 	Unhealthy Status = "unhealthy"
 )
 
+// Defines values for SubtaskDeletion.
+const (
+	SubtasksCascade SubtaskDeletion = "cascade"
+	SubtasksPromote SubtaskDeletion = "promote"
+)
+
 // Defines values for TaskStatus.
 const (
 	DONE       TaskStatus = "DONE"
@@ -22,24 +67,193 @@ const (
 	TODO       TaskStatus = "TODO"
 )
 
-// BadRequest Bad Request (malformed JSON or type mismatch)
-type BadRequest = interface{}
+// Defines values for ViewColumn.
+const (
+	ViewColumnCreatedAt   ViewColumn = "createdAt"
+	ViewColumnDescription ViewColumn = "description"
+	ViewColumnId          ViewColumn = "id"
+	ViewColumnStatus      ViewColumn = "status"
+	ViewColumnTitle       ViewColumn = "title"
+	ViewColumnUpdatedAt   ViewColumn = "updatedAt"
+)
+
+// Defines values for ListAllTasksParamsEmbed.
+const (
+	ListAllTasksParamsEmbedProject ListAllTasksParamsEmbed = "project"
+)
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	// Requests The sub-requests, at most the configured maximum (20 by default).
+	Requests []BatchSubRequest `json:"requests"`
+
+	// Transactional Run every sub-request in one transaction, all or nothing.
+	Transactional *bool `json:"transactional,omitempty"`
+}
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	// Committed Whether the changes of the succeeded sub-requests were kept.
+	Committed bool               `json:"committed"`
+	Failed    int                `json:"failed"`
+	Responses []BatchSubResponse `json:"responses"`
+	Succeeded int                `json:"succeeded"`
+}
+
+// BatchSubRequest defines model for BatchSubRequest.
+type BatchSubRequest struct {
+	// Body The JSON body of the sub-request.
+	Body    interface{}        `json:"body,omitempty"`
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Id Names the sub-request so later ones can refer to its response.
+	Id     *string               `json:"id,omitempty"`
+	Method BatchSubRequestMethod `json:"method"`
+
+	// Path Path and query string of the sub-request.
+	Path string `json:"path"`
+}
+
+// BatchSubRequestMethod defines model for BatchSubRequest.Method.
+type BatchSubRequestMethod string
+
+// BatchSubResponse defines model for BatchSubResponse.
+type BatchSubResponse struct {
+	// Body The body of the response, if it had one: as JSON when it was JSON, as a string otherwise.
+	Body    interface{}       `json:"body,omitempty"`
+	Headers map[string]string `json:"headers"`
+	Id      *string           `json:"id,omitempty"`
+	Status  int               `json:"status"`
+}
+
+// BulkMode atomic applies every operation or none; bestEffort keeps what succeeds.
+type BulkMode string
+
+// BulkTaskOp defines model for BulkTaskOp.
+type BulkTaskOp string
+
+// BulkTaskOperation One operation. create takes `task`; update takes `changes`; setStatus takes `status`. update, delete and setStatus take exactly one of `ids` and `filter`.
+type BulkTaskOperation struct {
+	Changes *UpdateTask `json:"changes,omitempty"`
+
+	// Filter Filter expression selecting tasks of the project, as in listTasks.
+	Filter *string               `json:"filter,omitempty"`
+	Ids    *[]openapi_types.UUID `json:"ids,omitempty"`
+	Op     BulkTaskOp            `json:"op"`
+	Status *TaskStatus           `json:"status,omitempty"`
+	Task   *NewTask              `json:"task,omitempty"`
+}
+
+// BulkTaskRequest defines model for BulkTaskRequest.
+type BulkTaskRequest struct {
+	// Mode atomic applies every operation or none; bestEffort keeps what succeeds.
+	Mode       *BulkMode           `json:"mode,omitempty"`
+	Operations []BulkTaskOperation `json:"operations"`
+}
+
+// BulkTaskResponse defines model for BulkTaskResponse.
+type BulkTaskResponse struct {
+	// Committed Whether the changes of the succeeded entries were kept.
+	Committed bool `json:"committed"`
+	Failed    int  `json:"failed"`
+
+	// Mode atomic applies every operation or none; bestEffort keeps what succeeds.
+	Mode      BulkMode         `json:"mode"`
+	Results   []BulkTaskResult `json:"results"`
+	Succeeded int              `json:"succeeded"`
+}
+
+// BulkTaskResult defines model for BulkTaskResult.
+type BulkTaskResult struct {
+	// Error Error envelope. `code` is a stable machine-readable identifier from the error catalog (VALIDATION_FAILED, MALFORMED_REQUEST, INVALID_PARAMETER, UNSUPPORTED_MEDIA_TYPE, INVALID_FILTER, INVALID_PATCH, PATCH_TEST_FAILED, BULK_ABORTED, BATCH_ABORTED, INVALID_REFERENCE, DEPENDENCY_FAILED, NOT_FOUND, PROJECT_NOT_FOUND, TASK_NOT_FOUND, VIEW_NOT_FOUND, DEPENDENCY_NOT_FOUND, LABEL_NOT_FOUND, PROJECT_NAME_EXISTS, VIEW_NAME_EXISTS, LABEL_NAME_EXISTS, DEPENDENCY_CYCLE, TASK_BLOCKED, PRECONDITION_FAILED, IDEMPOTENCY_KEY_REUSED, IDEMPOTENCY_KEY_IN_PROGRESS, REQUEST_CANCELLED, INTERNAL_ERROR); clients should branch on it rather than on `message`. 400 means the request could not be parsed, 422 means it parsed but broke a rule.
+	Error *Error `json:"error,omitempty"`
+
+	// Id The task, when known.
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Index Position of the operation in the request, from 0.
+	Index int        `json:"index"`
+	Op    BulkTaskOp `json:"op"`
 
-// Conflict Conflict (e.g., unique constraint)
-type Conflict = interface{}
+	// Status HTTP status the task would have got from a single request.
+	Status int   `json:"status"`
+	Task   *Task `json:"task,omitempty"`
+}
 
-// DefaultError Unexpected error
-type DefaultError = interface{}
+// ComponentHealth defines model for ComponentHealth.
+type ComponentHealth struct {
+	Details *map[string]interface{} `json:"details,omitempty"`
+	Message *string                 `json:"message,omitempty"`
+	Status  Status                  `json:"status"`
+}
 
-// Error defines model for Error.
+// Error Error envelope. `code` is a stable machine-readable identifier from the error catalog (VALIDATION_FAILED, MALFORMED_REQUEST, INVALID_PARAMETER, UNSUPPORTED_MEDIA_TYPE, INVALID_FILTER, INVALID_PATCH, PATCH_TEST_FAILED, BULK_ABORTED, BATCH_ABORTED, INVALID_REFERENCE, DEPENDENCY_FAILED, NOT_FOUND, PROJECT_NOT_FOUND, TASK_NOT_FOUND, VIEW_NOT_FOUND, DEPENDENCY_NOT_FOUND, LABEL_NOT_FOUND, PROJECT_NAME_EXISTS, VIEW_NAME_EXISTS, LABEL_NAME_EXISTS, DEPENDENCY_CYCLE, TASK_BLOCKED, PRECONDITION_FAILED, IDEMPOTENCY_KEY_REUSED, IDEMPOTENCY_KEY_IN_PROGRESS, REQUEST_CANCELLED, INTERNAL_ERROR); clients should branch on it rather than on `message`. 400 means the request could not be parsed, 422 means it parsed but broke a rule.
 type Error struct {
-	Code    int    `json:"code"`
+	Code    string         `json:"code"`
+	Details *[]ErrorDetail `json:"details,omitempty"`
+	Message string         `json:"message"`
+
+	// TraceId W3C trace ID of the request, also returned in the traceparent response header and logged as trace_id.
+	TraceId *string `json:"traceId,omitempty"`
+}
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	Field   string `json:"field"`
 	Message string `json:"message"`
 }
 
 // Health defines model for Health.
 type Health struct {
-	Status Status `json:"status"`
+	// Components Per-dependency results, keyed by component name.
+	Components *map[string]ComponentHealth `json:"components,omitempty"`
+	Status     Status                      `json:"status"`
+}
+
+// JsonPatch JSON Patch (RFC 6902) document, applied in order.
+type JsonPatch = []JsonPatchOperation
+
+// JsonPatchOperation defines model for JsonPatchOperation.
+type JsonPatchOperation struct {
+	// From JSON Pointer to the source of move and copy.
+	From *string              `json:"from,omitempty"`
+	Op   JsonPatchOperationOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) to the value the operation acts on.
+	Path string `json:"path"`
+
+	// Value The value of add, replace and test.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// JsonPatchOperationOp defines model for JsonPatchOperation.Op.
+type JsonPatchOperationOp string
+
+// Label A label of a project's catalog.
+type Label struct {
+	// Color RGB color as #rrggbb, stored in lower case.
+	Color     LabelColor         `json:"color"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	ProjectId openapi_types.UUID `json:"projectId"`
+
+	// TaskCount Number of tasks carrying the label.
+	TaskCount int       `json:"taskCount"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// LabelColor RGB color as #rrggbb, stored in lower case.
+type LabelColor = string
+
+// LabelMatch defines model for LabelMatch.
+type LabelMatch string
+
+// NewLabel defines model for NewLabel.
+type NewLabel struct {
+	// Color RGB color as #rrggbb, stored in lower case.
+	Color LabelColor `json:"color"`
+	Name  string     `json:"name"`
 }
 
 // NewProject defines model for NewProject.
@@ -49,13 +263,53 @@ type NewProject struct {
 
 // NewTask defines model for NewTask.
 type NewTask struct {
-	Description *string     `json:"description"`
-	Status      *TaskStatus `json:"status,omitempty"`
-	Title       string      `json:"title"`
+	Description *string `json:"description"`
+
+	// ParentId Makes the task a subtask of this task of the same project.
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+	Status   *TaskStatus         `json:"status,omitempty"`
+	Title    string              `json:"title"`
+}
+
+// NewView defines model for NewView.
+type NewView struct {
+	// Columns Defaults to title, status and updatedAt.
+	Columns *[]ViewColumn `json:"columns,omitempty"`
+	Filter  *string       `json:"filter,omitempty"`
+	Name    string        `json:"name"`
+	Sort    *string       `json:"sort,omitempty"`
 }
 
-// NotFound Resource not found
-type NotFound = interface{}
+// PageInfo defines model for PageInfo.
+type PageInfo struct {
+	Limit int `json:"limit"`
+
+	// NextCursor Pass as after to get the next page. Absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// Offset Offset of the page, when it was requested by offset.
+	Offset *int `json:"offset,omitempty"`
+
+	// PrevCursor Pass as before to get the previous page. Absent on the first page.
+	PrevCursor *string `json:"prevCursor,omitempty"`
+
+	// Total Number of rows matching the filters, across all pages.
+	Total int `json:"total"`
+}
+
+// Problem RFC 7807 representation of Error, returned as application/problem+json when the client asks for it in Accept.
+type Problem struct {
+	Code     string         `json:"code"`
+	Detail   *string        `json:"detail,omitempty"`
+	Details  *[]ErrorDetail `json:"details,omitempty"`
+	Instance *string        `json:"instance,omitempty"`
+	Status   int            `json:"status"`
+	Title    string         `json:"title"`
+
+	// TraceId W3C trace ID of the request, logged as trace_id.
+	TraceId *string `json:"traceId,omitempty"`
+	Type    string  `json:"type"`
+}
 
 // Project defines model for Project.
 type Project struct {
@@ -63,27 +317,147 @@ type Project struct {
 	Id        openapi_types.UUID `json:"id"`
 	Name      string             `json:"name"`
 	UpdatedAt time.Time          `json:"updatedAt"`
+
+	// Version Incremented on every change; the ETag of the project.
+	Version int `json:"version"`
+}
+
+// ProjectPage defines model for ProjectPage.
+type ProjectPage struct {
+	Items []Project `json:"items"`
+	Page  PageInfo  `json:"page"`
+}
+
+// ProjectTask defines model for ProjectTask.
+type ProjectTask struct {
+	// BlockedBy Tasks, in any project, that block this one. Absent when there are none. Adding or removing a dependency bumps the versions of both tasks.
+	BlockedBy *[]openapi_types.UUID `json:"blockedBy,omitempty"`
+
+	// Blocks Tasks, in any project, that this one blocks. Absent when there are none.
+	Blocks      *[]openapi_types.UUID `json:"blocks,omitempty"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	Description *string               `json:"description"`
+	Id          openapi_types.UUID    `json:"id"`
+
+	// Labels Labels on the task, by name. Absent when there are none.
+	Labels *[]TaskLabel `json:"labels,omitempty"`
+
+	// ParentId The task this one is a subtask of; null for top-level tasks.
+	ParentId *openapi_types.UUID `json:"parentId"`
+
+	// Progress How far the subtasks of a task, at every depth, have got. Only present on tasks that have subtasks. Adding, moving or deleting a subtask, or finishing or reopening one, bumps the versions of the tasks above it.
+	Progress  *TaskProgress      `json:"progress,omitempty"`
+	ProjectId openapi_types.UUID `json:"projectId"`
+
+	// ProjectName Name of the task's project. Only present with embed=project.
+	ProjectName *string    `json:"projectName,omitempty"`
+	Status      TaskStatus `json:"status"`
+	Title       string     `json:"title"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+
+	// Version Incremented on every change; the ETag of the task.
+	Version int `json:"version"`
+}
+
+// ProjectTaskPage defines model for ProjectTaskPage.
+type ProjectTaskPage struct {
+	Items []ProjectTask `json:"items"`
+	Page  PageInfo      `json:"page"`
 }
 
 // Status defines model for Status.
 type Status string
 
+// SubtaskDeletion cascade deletes the subtasks with their parent; promote keeps them, moving them up to the parent of the deleted task.
+type SubtaskDeletion string
+
 // Task defines model for Task.
 type Task struct {
-	CreatedAt   time.Time          `json:"createdAt"`
-	Description *string            `json:"description"`
-	Id          openapi_types.UUID `json:"id"`
-	ProjectId   openapi_types.UUID `json:"projectId"`
-	Status      TaskStatus         `json:"status"`
-	Title       string             `json:"title"`
-	UpdatedAt   time.Time          `json:"updatedAt"`
+	// BlockedBy Tasks, in any project, that block this one. Absent when there are none. Adding or removing a dependency bumps the versions of both tasks.
+	BlockedBy *[]openapi_types.UUID `json:"blockedBy,omitempty"`
+
+	// Blocks Tasks, in any project, that this one blocks. Absent when there are none.
+	Blocks      *[]openapi_types.UUID `json:"blocks,omitempty"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	Description *string               `json:"description"`
+	Id          openapi_types.UUID    `json:"id"`
+
+	// Labels Labels on the task, by name. Absent when there are none.
+	Labels *[]TaskLabel `json:"labels,omitempty"`
+
+	// ParentId The task this one is a subtask of; null for top-level tasks.
+	ParentId *openapi_types.UUID `json:"parentId"`
+
+	// Progress How far the subtasks of a task, at every depth, have got. Only present on tasks that have subtasks. Adding, moving or deleting a subtask, or finishing or reopening one, bumps the versions of the tasks above it.
+	Progress  *TaskProgress      `json:"progress,omitempty"`
+	ProjectId openapi_types.UUID `json:"projectId"`
+	Status    TaskStatus         `json:"status"`
+	Title     string             `json:"title"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+
+	// Version Incremented on every change; the ETag of the task.
+	Version int `json:"version"`
+}
+
+// TaskLabel A label as shown on the tasks carrying it.
+type TaskLabel struct {
+	// Color RGB color as #rrggbb, stored in lower case.
+	Color LabelColor         `json:"color"`
+	Id    openapi_types.UUID `json:"id"`
+	Name  string             `json:"name"`
+}
+
+// TaskMergePatch JSON Merge Patch (RFC 7396) of a task. Members left out keep their value; a null description clears it, a null parentId moves the task to the top level.
+type TaskMergePatch struct {
+	Description *string             `json:"description"`
+	ParentId    *openapi_types.UUID `json:"parentId"`
+	Status      *TaskStatus         `json:"status,omitempty"`
+	Title       *string             `json:"title,omitempty"`
+}
+
+// TaskPage defines model for TaskPage.
+type TaskPage struct {
+	Items []Task   `json:"items"`
+	Page  PageInfo `json:"page"`
+}
+
+// TaskProgress How far the subtasks of a task, at every depth, have got. Only present on tasks that have subtasks. Adding, moving or deleting a subtask, or finishing or reopening one, bumps the versions of the tasks above it.
+type TaskProgress struct {
+	// Done Subtasks in status DONE.
+	Done int `json:"done"`
+
+	// Total All subtasks.
+	Total int `json:"total"`
+}
+
+// TaskSearchHit defines model for TaskSearchHit.
+type TaskSearchHit struct {
+	// Score Relevance of the match; higher is better. Only comparable within one search.
+	Score float64 `json:"score"`
+
+	// Snippet HTML-escaped excerpt of the description around the matched terms, marked up like title. Absent when the task has no description.
+	Snippet *string `json:"snippet,omitempty"`
+	Task    Task    `json:"task"`
+
+	// Title HTML-escaped task title with the matched terms wrapped in <mark> tags.
+	Title string `json:"title"`
 }
 
 // TaskStatus defines model for TaskStatus.
 type TaskStatus string
 
-// Unprocessable Validation failed (well-formed request, semantic rules fail)
-type Unprocessable = interface{}
+// TaskTree defines model for TaskTree.
+type TaskTree struct {
+	Children []TaskTree `json:"children"`
+	Task     Task       `json:"task"`
+}
+
+// UpdateLabel defines model for UpdateLabel.
+type UpdateLabel struct {
+	// Color RGB color as #rrggbb, stored in lower case.
+	Color *LabelColor `json:"color,omitempty"`
+	Name  *string     `json:"name,omitempty"`
+}
 
 // UpdateProject defines model for UpdateProject.
 type UpdateProject struct {
@@ -92,27 +466,435 @@ type UpdateProject struct {
 
 // UpdateTask defines model for UpdateTask.
 type UpdateTask struct {
-	Description *string     `json:"description"`
-	Status      *TaskStatus `json:"status,omitempty"`
-	Title       *string     `json:"title,omitempty"`
+	Description *string `json:"description"`
+
+	// ParentId Moves the task under this task of the same project, or to the top level when empty. A task cannot move under one of its own subtasks.
+	ParentId *string     `json:"parentId,omitempty"`
+	Status   *TaskStatus `json:"status,omitempty"`
+	Title    *string     `json:"title,omitempty"`
+}
+
+// UpdateView defines model for UpdateView.
+type UpdateView struct {
+	Columns *[]ViewColumn `json:"columns,omitempty"`
+
+	// Filter Empty to remove the filter.
+	Filter *string `json:"filter,omitempty"`
+	Name   *string `json:"name,omitempty"`
+
+	// Sort Empty to go back to the default sort.
+	Sort *string `json:"sort,omitempty"`
+}
+
+// View A named task listing saved in a project.
+type View struct {
+	// Columns Task fields clients show for the view, in order.
+	Columns   []ViewColumn `json:"columns"`
+	CreatedAt time.Time    `json:"createdAt"`
+
+	// Filter Filter expression of the view, as taken by the filter parameter of the task listing.
+	Filter    *string            `json:"filter,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	ProjectId openapi_types.UUID `json:"projectId"`
+
+	// Sort Sort of the view, as taken by the sort parameter of the task listing.
+	Sort      *string   `json:"sort,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ViewColumn defines model for ViewColumn.
+type ViewColumn string
+
+// After defines model for After.
+type After = string
+
+// Before defines model for Before.
+type Before = string
+
+// CreatedAfter defines model for CreatedAfter.
+type CreatedAfter = time.Time
+
+// CreatedBefore defines model for CreatedBefore.
+type CreatedBefore = time.Time
+
+// Envelope defines model for Envelope.
+type Envelope = bool
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// Offset defines model for Offset.
+type Offset = int
+
+// TaskDescriptionFilter defines model for TaskDescriptionFilter.
+type TaskDescriptionFilter = string
+
+// TaskFilterExpression defines model for TaskFilterExpression.
+type TaskFilterExpression = string
+
+// TaskSort defines model for TaskSort.
+type TaskSort = string
+
+// TaskStatusFilter defines model for TaskStatusFilter.
+type TaskStatusFilter = []TaskStatus
+
+// TaskTitleFilter defines model for TaskTitleFilter.
+type TaskTitleFilter = string
+
+// UpdatedAfter defines model for UpdatedAfter.
+type UpdatedAfter = time.Time
+
+// UpdatedBefore defines model for UpdatedBefore.
+type UpdatedBefore = time.Time
+
+// BadRequestApplicationJSON Error envelope. `code` is a stable machine-readable identifier from the error catalog (VALIDATION_FAILED, MALFORMED_REQUEST, INVALID_PARAMETER, UNSUPPORTED_MEDIA_TYPE, INVALID_FILTER, INVALID_PATCH, PATCH_TEST_FAILED, BULK_ABORTED, BATCH_ABORTED, INVALID_REFERENCE, DEPENDENCY_FAILED, NOT_FOUND, PROJECT_NOT_FOUND, TASK_NOT_FOUND, VIEW_NOT_FOUND, DEPENDENCY_NOT_FOUND, LABEL_NOT_FOUND, PROJECT_NAME_EXISTS, VIEW_NAME_EXISTS, LABEL_NAME_EXISTS, DEPENDENCY_CYCLE, TASK_BLOCKED, PRECONDITION_FAILED, IDEMPOTENCY_KEY_REUSED, IDEMPOTENCY_KEY_IN_PROGRESS, REQUEST_CANCELLED, INTERNAL_ERROR); clients should branch on it rather than on `message`. 400 means the request could not be parsed, 422 means it parsed but broke a rule.
+type BadRequestApplicationJSON = Error
+
+// BadRequestApplicationProblemPlusJSON RFC 7807 representation of Error, returned as application/problem+json when the client asks for it in Accept.
+type BadRequestApplicationProblemPlusJSON = Problem
+
+// ConflictApplicationJSON Error envelope. `code` is a stable machine-readable identifier from the error catalog (VALIDATION_FAILED, MALFORMED_REQUEST, INVALID_PARAMETER, UNSUPPORTED_MEDIA_TYPE, INVALID_FILTER, INVALID_PATCH, PATCH_TEST_FAILED, BULK_ABORTED, BATCH_ABORTED, INVALID_REFERENCE, DEPENDENCY_FAILED, NOT_FOUND, PROJECT_NOT_FOUND, TASK_NOT_FOUND, VIEW_NOT_FOUND, DEPENDENCY_NOT_FOUND, LABEL_NOT_FOUND, PROJECT_NAME_EXISTS, VIEW_NAME_EXISTS, LABEL_NAME_EXISTS, DEPENDENCY_CYCLE, TASK_BLOCKED, PRECONDITION_FAILED, IDEMPOTENCY_KEY_REUSED, IDEMPOTENCY_KEY_IN_PROGRESS, REQUEST_CANCELLED, INTERNAL_ERROR); clients should branch on it rather than on `message`. 400 means the request could not be parsed, 422 means it parsed but broke a rule.
+type ConflictApplicationJSON = Error
+
+// ConflictApplicationProblemPlusJSON RFC 7807 representation of Error, returned as application/problem+json when the client asks for it in Accept.
+type ConflictApplicationProblemPlusJSON = Problem
+
+// DefaultErrorApplicationJSON Error envelope. `code` is a stable machine-readable identifier from the error catalog (VALIDATION_FAILED, MALFORMED_REQUEST, INVALID_PARAMETER, UNSUPPORTED_MEDIA_TYPE, INVALID_FILTER, INVALID_PATCH, PATCH_TEST_FAILED, BULK_ABORTED, BATCH_ABORTED, INVALID_REFERENCE, DEPENDENCY_FAILED, NOT_FOUND, PROJECT_NOT_FOUND, TASK_NOT_FOUND, VIEW_NOT_FOUND, DEPENDENCY_NOT_FOUND, LABEL_NOT_FOUND, PROJECT_NAME_EXISTS, VIEW_NAME_EXISTS, LABEL_NAME_EXISTS, DEPENDENCY_CYCLE, TASK_BLOCKED, PRECONDITION_FAILED, IDEMPOTENCY_KEY_REUSED, IDEMPOTENCY_KEY_IN_PROGRESS, REQUEST_CANCELLED, INTERNAL_ERROR); clients should branch on it rather than on `message`. 400 means the request could not be parsed, 422 means it parsed but broke a rule.
+type DefaultErrorApplicationJSON = Error
+
+// DefaultErrorApplicationProblemPlusJSON RFC 7807 representation of Error, returned as application/problem+json when the client asks for it in Accept.
+type DefaultErrorApplicationProblemPlusJSON = Problem
+
+// NotFoundApplicationJSON Error envelope. `code` is a stable machine-readable identifier from the error catalog (VALIDATION_FAILED, MALFORMED_REQUEST, INVALID_PARAMETER, UNSUPPORTED_MEDIA_TYPE, INVALID_FILTER, INVALID_PATCH, PATCH_TEST_FAILED, BULK_ABORTED, BATCH_ABORTED, INVALID_REFERENCE, DEPENDENCY_FAILED, NOT_FOUND, PROJECT_NOT_FOUND, TASK_NOT_FOUND, VIEW_NOT_FOUND, DEPENDENCY_NOT_FOUND, LABEL_NOT_FOUND, PROJECT_NAME_EXISTS, VIEW_NAME_EXISTS, LABEL_NAME_EXISTS, DEPENDENCY_CYCLE, TASK_BLOCKED, PRECONDITION_FAILED, IDEMPOTENCY_KEY_REUSED, IDEMPOTENCY_KEY_IN_PROGRESS, REQUEST_CANCELLED, INTERNAL_ERROR); clients should branch on it rather than on `message`. 400 means the request could not be parsed, 422 means it parsed but broke a rule.
+type NotFoundApplicationJSON = Error
+
+// NotFoundApplicationProblemPlusJSON RFC 7807 representation of Error, returned as application/problem+json when the client asks for it in Accept.
+type NotFoundApplicationProblemPlusJSON = Problem
+
+// UnprocessableApplicationJSON Error envelope. `code` is a stable machine-readable identifier from the error catalog (VALIDATION_FAILED, MALFORMED_REQUEST, INVALID_PARAMETER, UNSUPPORTED_MEDIA_TYPE, INVALID_FILTER, INVALID_PATCH, PATCH_TEST_FAILED, BULK_ABORTED, BATCH_ABORTED, INVALID_REFERENCE, DEPENDENCY_FAILED, NOT_FOUND, PROJECT_NOT_FOUND, TASK_NOT_FOUND, VIEW_NOT_FOUND, DEPENDENCY_NOT_FOUND, LABEL_NOT_FOUND, PROJECT_NAME_EXISTS, VIEW_NAME_EXISTS, LABEL_NAME_EXISTS, DEPENDENCY_CYCLE, TASK_BLOCKED, PRECONDITION_FAILED, IDEMPOTENCY_KEY_REUSED, IDEMPOTENCY_KEY_IN_PROGRESS, REQUEST_CANCELLED, INTERNAL_ERROR); clients should branch on it rather than on `message`. 400 means the request could not be parsed, 422 means it parsed but broke a rule.
+type UnprocessableApplicationJSON = Error
+
+// UnprocessableApplicationProblemPlusJSON RFC 7807 representation of Error, returned as application/problem+json when the client asks for it in Accept.
+type UnprocessableApplicationProblemPlusJSON = Problem
+
+// BatchParams defines parameters for Batch.
+type BatchParams struct {
+	// IdempotencyKey Client-chosen key, such as a UUID, that makes the request safe to retry. The first request with a key runs; if it succeeds, its response is kept for the configured window, a day by default, and replayed to every retry with the same key. Reusing the key for a different request fails with 422 IDEMPOTENCY_KEY_REUSED, and retrying while the first request still runs fails with 409 IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ListProjectsParams defines parameters for ListProjects.
+type ListProjectsParams struct {
+	// Limit Page size.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Kept for existing clients; prefer the after/before cursors, which do not skip or repeat rows changed between requests.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// After Opaque cursor; returns the page following it. Excludes before and offset.
+	After *After `form:"after,omitempty" json:"after,omitempty"`
+
+	// Before Opaque cursor; returns the page preceding it. Excludes after and offset.
+	Before *Before `form:"before,omitempty" json:"before,omitempty"`
+
+	// Envelope Wrap the page in an object carrying its paging metadata instead of returning a bare array.
+	Envelope *Envelope `form:"envelope,omitempty" json:"envelope,omitempty"`
+}
+
+// CreateProjectParams defines parameters for CreateProject.
+type CreateProjectParams struct {
+	// IdempotencyKey Client-chosen key, such as a UUID, that makes the request safe to retry. The first request with a key runs; if it succeeds, its response is kept for the configured window, a day by default, and replayed to every retry with the same key. Reusing the key for a different request fails with 422 IDEMPOTENCY_KEY_REUSED, and retrying while the first request still runs fails with 409 IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteProjectParams defines parameters for DeleteProject.
+type DeleteProjectParams struct {
+	// IfMatch Only apply the request if the resource's current ETag is one of these entity tags, or if it is "*". Otherwise the request fails with 412 PRECONDITION_FAILED and changes nothing.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetProjectParams defines parameters for GetProject.
+type GetProjectParams struct {
+	// IfNoneMatch Answer 304 Not Modified without a body if the resource's current ETag is one of these entity tags, or if it is "*".
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// UpdateProjectParams defines parameters for UpdateProject.
+type UpdateProjectParams struct {
+	// IfMatch Only apply the request if the resource's current ETag is one of these entity tags, or if it is "*". Otherwise the request fails with 412 PRECONDITION_FAILED and changes nothing.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// CreateLabelParams defines parameters for CreateLabel.
+type CreateLabelParams struct {
+	// IdempotencyKey Client-chosen key, such as a UUID, that makes the request safe to retry. The first request with a key runs; if it succeeds, its response is kept for the configured window, a day by default, and replayed to every retry with the same key. Reusing the key for a different request fails with 422 IDEMPOTENCY_KEY_REUSED, and retrying while the first request still runs fails with 409 IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
 // ListTasksParams defines parameters for ListTasks.
 type ListTasksParams struct {
-	// Status Filter by task status
-	Status *TaskStatus `form:"status,omitempty" json:"status,omitempty"`
+	// TopLevel Only list tasks without a parent.
+	TopLevel *bool `form:"topLevel,omitempty" json:"topLevel,omitempty"`
+
+	// Label Filter by label name, regardless of case. Repeat to name several (label=bug&label=ui); labelMatch says whether a task needs any or all of them.
+	Label *[]string `form:"label,omitempty" json:"label,omitempty"`
+
+	// LabelMatch Whether a task must carry any or all of the labels named by label.
+	LabelMatch *LabelMatch `form:"labelMatch,omitempty" json:"labelMatch,omitempty"`
+
+	// Status Filter by task status. Repeat to match any of several (status=TODO&status=DONE).
+	Status *TaskStatusFilter `form:"status,omitempty" json:"status,omitempty"`
 
 	// Q Case-insensitive title contains
-	Q      *string `form:"q,omitempty" json:"q,omitempty"`
-	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
+	Q *TaskTitleFilter `form:"q,omitempty" json:"q,omitempty"`
+
+	// Description Case-insensitive description contains
+	Description *TaskDescriptionFilter `form:"description,omitempty" json:"description,omitempty"`
+
+	// CreatedAfter Only tasks created at or after this time.
+	CreatedAfter *CreatedAfter `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore Only tasks created before this time.
+	CreatedBefore *CreatedBefore `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+
+	// UpdatedAfter Only tasks updated at or after this time.
+	UpdatedAfter *UpdatedAfter `form:"updatedAfter,omitempty" json:"updatedAfter,omitempty"`
+
+	// UpdatedBefore Only tasks updated before this time.
+	UpdatedBefore *UpdatedBefore `form:"updatedBefore,omitempty" json:"updatedBefore,omitempty"`
+
+	// Filter Filter expression, applied on top of the other filters, e.g. status in (TODO, IN_PROGRESS) and title ~ "deploy" and updatedAt > -7d. Fields: status (=, !=, in, not in), title and description (=, !=, ~ for contains ignoring case, !~, in, not in), createdAt and updatedAt (<, <=, >, >= against an RFC 3339 time, a date, now or an offset from now such as -7d, -12h or +1w). Combine with and, or, not and parentheses; quote values containing spaces with " or '. Errors are reported as INVALID_FILTER with the column at fault.
+	Filter *TaskFilterExpression `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Sort Comma-separated sort keys, each optionally prefixed with - for descending order, e.g. -createdAt,title. Keys: createdAt, updatedAt, title, status. Defaults to -updatedAt. Cursors only work with the sort they were issued for.
+	Sort *TaskSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Page size.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Kept for existing clients; prefer the after/before cursors, which do not skip or repeat rows changed between requests.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// After Opaque cursor; returns the page following it. Excludes before and offset.
+	After *After `form:"after,omitempty" json:"after,omitempty"`
+
+	// Before Opaque cursor; returns the page preceding it. Excludes after and offset.
+	Before *Before `form:"before,omitempty" json:"before,omitempty"`
+
+	// Envelope Wrap the page in an object carrying its paging metadata instead of returning a bare array.
+	Envelope *Envelope `form:"envelope,omitempty" json:"envelope,omitempty"`
+}
+
+// CreateTaskParams defines parameters for CreateTask.
+type CreateTaskParams struct {
+	// IdempotencyKey Client-chosen key, such as a UUID, that makes the request safe to retry. The first request with a key runs; if it succeeds, its response is kept for the configured window, a day by default, and replayed to every retry with the same key. Reusing the key for a different request fails with 422 IDEMPOTENCY_KEY_REUSED, and retrying while the first request still runs fails with 409 IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// DeleteTaskParams defines parameters for DeleteTask.
+type DeleteTaskParams struct {
+	// Subtasks What happens to the subtasks of the deleted task.
+	Subtasks *SubtaskDeletion `form:"subtasks,omitempty" json:"subtasks,omitempty"`
+
+	// IfMatch Only apply the request if the resource's current ETag is one of these entity tags, or if it is "*". Otherwise the request fails with 412 PRECONDITION_FAILED and changes nothing.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetTaskParams defines parameters for GetTask.
+type GetTaskParams struct {
+	// IfNoneMatch Answer 304 Not Modified without a body if the resource's current ETag is one of these entity tags, or if it is "*".
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// PatchTaskParams defines parameters for PatchTask.
+type PatchTaskParams struct {
+	// IfMatch Only apply the request if the resource's current ETag is one of these entity tags, or if it is "*". Otherwise the request fails with 412 PRECONDITION_FAILED and changes nothing.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdateTaskParams defines parameters for UpdateTask.
+type UpdateTaskParams struct {
+	// IfMatch Only apply the request if the resource's current ETag is one of these entity tags, or if it is "*". Otherwise the request fails with 412 PRECONDITION_FAILED and changes nothing.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ListTaskChildrenParams defines parameters for ListTaskChildren.
+type ListTaskChildrenParams struct {
+	// Status Filter by task status. Repeat to match any of several (status=TODO&status=DONE).
+	Status *TaskStatusFilter `form:"status,omitempty" json:"status,omitempty"`
+
+	// Filter Filter expression, applied on top of the other filters, e.g. status in (TODO, IN_PROGRESS) and title ~ "deploy" and updatedAt > -7d. Fields: status (=, !=, in, not in), title and description (=, !=, ~ for contains ignoring case, !~, in, not in), createdAt and updatedAt (<, <=, >, >= against an RFC 3339 time, a date, now or an offset from now such as -7d, -12h or +1w). Combine with and, or, not and parentheses; quote values containing spaces with " or '. Errors are reported as INVALID_FILTER with the column at fault.
+	Filter *TaskFilterExpression `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Sort Comma-separated sort keys, each optionally prefixed with - for descending order, e.g. -createdAt,title. Keys: createdAt, updatedAt, title, status. Defaults to -updatedAt. Cursors only work with the sort they were issued for.
+	Sort *TaskSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Page size.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Kept for existing clients; prefer the after/before cursors, which do not skip or repeat rows changed between requests.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// After Opaque cursor; returns the page following it. Excludes before and offset.
+	After *After `form:"after,omitempty" json:"after,omitempty"`
+
+	// Before Opaque cursor; returns the page preceding it. Excludes after and offset.
+	Before *Before `form:"before,omitempty" json:"before,omitempty"`
+
+	// Envelope Wrap the page in an object carrying its paging metadata instead of returning a bare array.
+	Envelope *Envelope `form:"envelope,omitempty" json:"envelope,omitempty"`
+}
+
+// BulkTasksParams defines parameters for BulkTasks.
+type BulkTasksParams struct {
+	// IdempotencyKey Client-chosen key, such as a UUID, that makes the request safe to retry. The first request with a key runs; if it succeeds, its response is kept for the configured window, a day by default, and replayed to every retry with the same key. Reusing the key for a different request fails with 422 IDEMPOTENCY_KEY_REUSED, and retrying while the first request still runs fails with 409 IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateViewParams defines parameters for CreateView.
+type CreateViewParams struct {
+	// IdempotencyKey Client-chosen key, such as a UUID, that makes the request safe to retry. The first request with a key runs; if it succeeds, its response is kept for the configured window, a day by default, and replayed to every retry with the same key. Reusing the key for a different request fails with 422 IDEMPOTENCY_KEY_REUSED, and retrying while the first request still runs fails with 409 IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ListViewTasksParams defines parameters for ListViewTasks.
+type ListViewTasksParams struct {
+	// Limit Page size.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Kept for existing clients; prefer the after/before cursors, which do not skip or repeat rows changed between requests.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// After Opaque cursor; returns the page following it. Excludes before and offset.
+	After *After `form:"after,omitempty" json:"after,omitempty"`
+
+	// Before Opaque cursor; returns the page preceding it. Excludes after and offset.
+	Before *Before `form:"before,omitempty" json:"before,omitempty"`
+
+	// Envelope Wrap the page in an object carrying its paging metadata instead of returning a bare array.
+	Envelope *Envelope `form:"envelope,omitempty" json:"envelope,omitempty"`
+}
+
+// ListAllTasksParams defines parameters for ListAllTasks.
+type ListAllTasksParams struct {
+	// ProjectId Only list the tasks of these projects. Repeat to give several.
+	ProjectId *[]openapi_types.UUID `form:"projectId,omitempty" json:"projectId,omitempty"`
+
+	// Embed Related resources to include with each task.
+	Embed *[]ListAllTasksParamsEmbed `form:"embed,omitempty" json:"embed,omitempty"`
+
+	// TopLevel Only list tasks without a parent.
+	TopLevel *bool `form:"topLevel,omitempty" json:"topLevel,omitempty"`
+
+	// Label Filter by label name, regardless of case. Repeat to name several (label=bug&label=ui); labelMatch says whether a task needs any or all of them. Labels belong to a project, so a name matches the labels of that name in every project listed.
+	Label *[]string `form:"label,omitempty" json:"label,omitempty"`
+
+	// LabelMatch Whether a task must carry any or all of the labels named by label.
+	LabelMatch *LabelMatch `form:"labelMatch,omitempty" json:"labelMatch,omitempty"`
+
+	// Status Filter by task status. Repeat to match any of several (status=TODO&status=DONE).
+	Status *TaskStatusFilter `form:"status,omitempty" json:"status,omitempty"`
+
+	// Q Case-insensitive title contains
+	Q *TaskTitleFilter `form:"q,omitempty" json:"q,omitempty"`
+
+	// Description Case-insensitive description contains
+	Description *TaskDescriptionFilter `form:"description,omitempty" json:"description,omitempty"`
+
+	// CreatedAfter Only tasks created at or after this time.
+	CreatedAfter *CreatedAfter `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore Only tasks created before this time.
+	CreatedBefore *CreatedBefore `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+
+	// UpdatedAfter Only tasks updated at or after this time.
+	UpdatedAfter *UpdatedAfter `form:"updatedAfter,omitempty" json:"updatedAfter,omitempty"`
+
+	// UpdatedBefore Only tasks updated before this time.
+	UpdatedBefore *UpdatedBefore `form:"updatedBefore,omitempty" json:"updatedBefore,omitempty"`
+
+	// Filter Filter expression, applied on top of the other filters, e.g. status in (TODO, IN_PROGRESS) and title ~ "deploy" and updatedAt > -7d. Fields: status (=, !=, in, not in), title and description (=, !=, ~ for contains ignoring case, !~, in, not in), createdAt and updatedAt (<, <=, >, >= against an RFC 3339 time, a date, now or an offset from now such as -7d, -12h or +1w). Combine with and, or, not and parentheses; quote values containing spaces with " or '. Errors are reported as INVALID_FILTER with the column at fault.
+	Filter *TaskFilterExpression `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Sort Comma-separated sort keys, each optionally prefixed with - for descending order, e.g. -createdAt,title. Keys: createdAt, updatedAt, title, status. Defaults to -updatedAt. Cursors only work with the sort they were issued for.
+	Sort *TaskSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Limit Page size.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Kept for existing clients; prefer the after/before cursors, which do not skip or repeat rows changed between requests.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// After Opaque cursor; returns the page following it. Excludes before and offset.
+	After *After `form:"after,omitempty" json:"after,omitempty"`
+
+	// Before Opaque cursor; returns the page preceding it. Excludes after and offset.
+	Before *Before `form:"before,omitempty" json:"before,omitempty"`
+
+	// Envelope Wrap the page in an object carrying its paging metadata instead of returning a bare array.
+	Envelope *Envelope `form:"envelope,omitempty" json:"envelope,omitempty"`
+}
+
+// ListAllTasksParamsEmbed defines parameters for ListAllTasks.
+type ListAllTasksParamsEmbed string
+
+// SearchTasksParams defines parameters for SearchTasks.
+type SearchTasksParams struct {
+	// Q Search terms. A task matches when it contains every term; put OR between terms to match either. End a term with * to match it as a prefix, prefix it with - to exclude tasks containing it, and put words in double quotes to match them as a phrase.
+	Q string `form:"q" json:"q"`
+
+	// ProjectId Only search the tasks of this project.
+	ProjectId *openapi_types.UUID `form:"projectId,omitempty" json:"projectId,omitempty"`
+
+	// Limit Page size.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Rows to skip. Kept for existing clients; prefer the after/before cursors, which do not skip or repeat rows changed between requests.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// BatchJSONRequestBody defines body for Batch for application/json ContentType.
+type BatchJSONRequestBody = BatchRequest
+
 // CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
 type CreateProjectJSONRequestBody = NewProject
 
+// UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
+type UpdateProjectJSONRequestBody = UpdateProject
+
+// CreateLabelJSONRequestBody defines body for CreateLabel for application/json ContentType.
+type CreateLabelJSONRequestBody = NewLabel
+
+// UpdateLabelJSONRequestBody defines body for UpdateLabel for application/json ContentType.
+type UpdateLabelJSONRequestBody = UpdateLabel
+
 // CreateTaskJSONRequestBody defines body for CreateTask for application/json ContentType.
 type CreateTaskJSONRequestBody = NewTask
 
+// PatchTaskApplicationJSONPatchPlusJSONRequestBody defines body for PatchTask for application/json-patch+json ContentType.
+type PatchTaskApplicationJSONPatchPlusJSONRequestBody = JsonPatch
+
+// PatchTaskApplicationMergePatchPlusJSONRequestBody defines body for PatchTask for application/merge-patch+json ContentType.
+type PatchTaskApplicationMergePatchPlusJSONRequestBody = TaskMergePatch
+
 // UpdateTaskJSONRequestBody defines body for UpdateTask for application/json ContentType.
 type UpdateTaskJSONRequestBody = UpdateTask
+
+// BulkTasksJSONRequestBody defines body for BulkTasks for application/json ContentType.
+type BulkTasksJSONRequestBody = BulkTaskRequest
+
+// CreateViewJSONRequestBody defines body for CreateView for application/json ContentType.
+type CreateViewJSONRequestBody = NewView
+
+// UpdateViewJSONRequestBody defines body for UpdateView for application/json ContentType.
+type UpdateViewJSONRequestBody = UpdateView
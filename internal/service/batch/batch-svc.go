@@ -0,0 +1,409 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/config"
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/requestid"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/store"
+	"full-stack-assesment/internal/telemetry"
+)
+
+// batchPath is the path of the batch endpoint, which sub-requests may not
+// call.
+const batchPath = "/batch"
+
+// errBatchAborted stops a transactional batch at its first failing
+// sub-request, so that InTx rolls everything back.
+var errBatchAborted = errors.New("batch aborted")
+
+// referenceRe matches a reference to the response of an earlier sub-request:
+// ${id.body.member.0.member} or ${id.headers.Name}.
+var referenceRe = regexp.MustCompile(`\$\{([A-Za-z0-9_-]{1,64})\.([^}]*)\}`)
+
+// BatchService runs batch requests: several API requests sent as one, each
+// handed to the API handler as if it had arrived on its own. It is shared by
+// pointer because that handler is built around it and set with Route once
+// the middleware chain is complete.
+type BatchService struct {
+	db      *store.DB
+	limits  config.Limits
+	handler http.Handler
+}
+
+func NewService(db *store.DB, limits config.Limits) *BatchService {
+	return &BatchService{db: db, limits: limits}
+}
+
+// Route sets the handler sub-requests are sent to: the whole API, middleware
+// included.
+func (s *BatchService) Route(h http.Handler) {
+	s.handler = h
+}
+
+// result is a sub-request that has run, kept for the references of later
+// ones.
+type result struct {
+	status int
+	header http.Header
+	// body is the decoded JSON body, nil when there was none.
+	body any
+}
+
+// Run sends the sub-requests of req to the API handler in order and collects
+// their responses. Sub-requests inherit the context of r, with its request
+// ID and trace, and its remote address. With req.Transactional, they all run
+// in one transaction that the first failing sub-request rolls back.
+func (s *BatchService) Run(r *http.Request, req scheme.BatchRequest) (_ *scheme.BatchResponse, err error) {
+	ctx, span := telemetry.Start(r.Context(), "BatchService.Run")
+	defer func() { span.End(err) }()
+
+	if err := s.check(req.Requests); err != nil {
+		return nil, err
+	}
+
+	out := &scheme.BatchResponse{Responses: make([]scheme.BatchSubResponse, 0, len(req.Requests))}
+	run := func(ctx context.Context) error {
+		done := map[string]result{}
+		for _, sub := range req.Requests {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			res, kept := s.serve(ctx, r, sub, done)
+			out.Responses = append(out.Responses, res)
+			if sub.Id != nil {
+				done[*sub.Id] = kept
+			}
+			if res.Status >= http.StatusBadRequest && req.Transactional != nil && *req.Transactional {
+				return errBatchAborted
+			}
+		}
+		return nil
+	}
+	if req.Transactional != nil && *req.Transactional {
+		err = s.db.InTx(ctx, run)
+	} else {
+		err = run(ctx)
+	}
+
+	switch {
+	case errors.Is(err, errBatchAborted):
+		abortBatch(ctx, out, req.Requests)
+	case err != nil:
+		return nil, err
+	default:
+		out.Committed = true
+	}
+	for _, res := range out.Responses {
+		if res.Status < http.StatusBadRequest {
+			out.Succeeded++
+		} else {
+			out.Failed++
+		}
+	}
+	return out, nil
+}
+
+// check rejects a batch that is too long, reuses an id or calls the batch
+// endpoint, before any of it runs.
+func (s *BatchService) check(subs []scheme.BatchSubRequest) error {
+	if len(subs) > s.limits.BatchMaxRequests {
+		return apierrors.InvalidField("requests", fmt.Sprintf("must hold at most %d requests", s.limits.BatchMaxRequests))
+	}
+	seen := map[string]bool{}
+	for i, sub := range subs {
+		field := func(name string) string { return "requests." + strconv.Itoa(i) + "." + name }
+		if sub.Id != nil {
+			if seen[*sub.Id] {
+				return apierrors.InvalidField(field("id"), "is used by an earlier request")
+			}
+			seen[*sub.Id] = true
+		}
+		u, err := url.Parse(sub.Path)
+		if err != nil || u.Scheme != "" || u.Host != "" {
+			return apierrors.InvalidField(field("path"), "must be an absolute path")
+		}
+		if u.Path == batchPath {
+			return apierrors.InvalidField(field("path"), "batches cannot be nested")
+		}
+	}
+	return nil
+}
+
+// serve resolves the references of sub and sends it to the API handler. It
+// returns the response as reported to the client and as kept for later
+// references.
+func (s *BatchService) serve(ctx context.Context, parent *http.Request, sub scheme.BatchSubRequest, done map[string]result) (scheme.BatchSubResponse, result) {
+	res := scheme.BatchSubResponse{Id: sub.Id}
+
+	req, err := buildRequest(ctx, sub, done)
+	if err != nil {
+		apiErr := apierrors.From(err)
+		res.Status = apiErr.Status
+		res.Headers = map[string]string{"Content-Type": "application/json; charset=utf-8"}
+		res.Body = helpers.ErrorBody(ctx, apiErr)
+		return res, result{status: apiErr.Status}
+	}
+	req.RemoteAddr = parent.RemoteAddr
+	if ids, ok := requestid.FromContext(ctx); ok {
+		req.Header.Set(requestid.Header, ids.RequestID)
+		req.Header.Set(requestid.TraceparentHeader, ids.Traceparent())
+	}
+
+	rec := &recorder{header: http.Header{}, status: http.StatusOK}
+	s.handler.ServeHTTP(rec, req)
+
+	kept := result{status: rec.status, header: rec.header}
+	res.Status = rec.status
+	res.Headers = make(map[string]string, len(rec.header))
+	for k, v := range rec.header {
+		res.Headers[k] = strings.Join(v, ", ")
+	}
+	if body := bytes.TrimSpace(rec.body.Bytes()); len(body) > 0 {
+		if isJSON(rec.header.Get("Content-Type")) && json.Valid(body) {
+			res.Body = json.RawMessage(body)
+			kept.body = decodeJSON(body)
+		} else {
+			res.Body = string(body)
+		}
+	}
+	return res, kept
+}
+
+// buildRequest turns sub into an HTTP request, replacing the references in
+// its path, headers and body.
+func buildRequest(ctx context.Context, sub scheme.BatchSubRequest, done map[string]result) (*http.Request, error) {
+	path, err := interpolate(sub.Path, done, url.PathEscape)
+	if err != nil {
+		return nil, err
+	}
+	if u, err := url.Parse(path); err != nil || u.Path == batchPath {
+		return nil, apierrors.InvalidField("path", "must be an API path other than "+batchPath)
+	}
+
+	var body []byte
+	if sub.Body != nil {
+		v, err := resolveBody(sub.Body, done)
+		if err != nil {
+			return nil, err
+		}
+		if body, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, string(sub.Method), path, bytes.NewReader(body))
+	if err != nil {
+		return nil, apierrors.InvalidField("path", "must be an API path")
+	}
+	req.RequestURI = path
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if sub.Headers != nil {
+		for k, v := range *sub.Headers {
+			if v, err = interpolate(v, done, nil); err != nil {
+				return nil, err
+			}
+			req.Header.Set(k, v)
+		}
+	}
+	return req, nil
+}
+
+// resolveBody returns v with the references in its strings replaced. A
+// string that is a single reference and nothing else becomes the referred
+// value itself, whatever its JSON type.
+func resolveBody(v any, done map[string]result) (any, error) {
+	switch v := v.(type) {
+	case string:
+		if m := referenceRe.FindStringSubmatchIndex(v); m != nil && m[0] == 0 && m[1] == len(v) {
+			return lookup(v[m[2]:m[3]], v[m[4]:m[5]], done)
+		}
+		return interpolate(v, done, nil)
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			var err error
+			if out[k], err = resolveBody(e, done); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(v))
+		for i, e := range v {
+			var err error
+			if out[i], err = resolveBody(e, done); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	default:
+		return v, nil
+	}
+}
+
+// interpolate replaces every reference in s by the referred value, which must
+// be a string, number or boolean, passed through escape if given.
+func interpolate(s string, done map[string]result, escape func(string) string) (string, error) {
+	var firstErr error
+	out := referenceRe.ReplaceAllStringFunc(s, func(ref string) string {
+		m := referenceRe.FindStringSubmatch(ref)
+		v, err := lookup(m[1], m[2], done)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return ref
+		}
+		var text string
+		switch v := v.(type) {
+		case string:
+			text = v
+		case json.Number:
+			text = v.String()
+		case bool:
+			text = strconv.FormatBool(v)
+		default:
+			if firstErr == nil {
+				firstErr = invalidReference(ref, "is not a string, number or boolean")
+			}
+			return ref
+		}
+		if escape != nil {
+			text = escape(text)
+		}
+		return text
+	})
+	return out, firstErr
+}
+
+// lookup returns the member path of the response of the sub-request id:
+// body followed by dot-separated members and array indexes, or headers
+// followed by a header name.
+func lookup(id, path string, done map[string]result) (any, error) {
+	ref := "${" + id + "." + path + "}"
+	res, ok := done[id]
+	if !ok {
+		return nil, invalidReference(ref, "no earlier request has id "+id)
+	}
+	if res.status >= http.StatusBadRequest {
+		return nil, apierrors.ErrDependencyFailed.
+			WithMessage("not run: request " + id + " failed with status " + strconv.Itoa(res.status)).
+			WithDetails(apierrors.FieldError{Field: ref, Message: "refers to a failed request"})
+	}
+
+	tokens := strings.Split(path, ".")
+	switch tokens[0] {
+	case "headers":
+		if len(tokens) == 2 {
+			if v := res.header.Values(tokens[1]); len(v) > 0 {
+				return strings.Join(v, ", "), nil
+			}
+		}
+		return nil, invalidReference(ref, "names no header of the response")
+	case "body":
+		v := res.body
+		for _, t := range tokens[1:] {
+			switch node := v.(type) {
+			case map[string]any:
+				if v, ok = node[t]; !ok {
+					return nil, invalidReference(ref, "names no member of the response body")
+				}
+			case []any:
+				i, err := strconv.Atoi(t)
+				if err != nil || i < 0 || i >= len(node) {
+					return nil, invalidReference(ref, "names no member of the response body")
+				}
+				v = node[i]
+			default:
+				return nil, invalidReference(ref, "names no member of the response body")
+			}
+		}
+		if v == nil {
+			return nil, invalidReference(ref, "names no member of the response body")
+		}
+		return v, nil
+	default:
+		return nil, invalidReference(ref, "must continue with .body or .headers")
+	}
+}
+
+func invalidReference(ref, reason string) *apierrors.Error {
+	return apierrors.ErrInvalidReference.
+		WithMessage("invalid reference " + ref + ": " + reason).
+		WithDetails(apierrors.FieldError{Field: ref, Message: reason})
+}
+
+// abortBatch rewrites the responses of a transactional batch that rolled
+// back: every sub-request but the failing one answers BATCH_ABORTED,
+// including those that never ran.
+func abortBatch(ctx context.Context, out *scheme.BatchResponse, subs []scheme.BatchSubRequest) {
+	aborted := func(id *string) scheme.BatchSubResponse {
+		return scheme.BatchSubResponse{
+			Id:      id,
+			Status:  apierrors.ErrBatchAborted.Status,
+			Headers: map[string]string{"Content-Type": "application/json; charset=utf-8"},
+			Body:    helpers.ErrorBody(ctx, apierrors.ErrBatchAborted),
+		}
+	}
+	for i, res := range out.Responses {
+		if res.Status < http.StatusBadRequest {
+			out.Responses[i] = aborted(res.Id)
+		}
+	}
+	for _, sub := range subs[len(out.Responses):] {
+		out.Responses = append(out.Responses, aborted(sub.Id))
+	}
+}
+
+// isJSON reports whether a Content-Type names JSON or a +json type.
+func isJSON(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mt == "application/json" || strings.HasSuffix(mt, "+json"))
+}
+
+// decodeJSON decodes a JSON document keeping numbers as written.
+func decodeJSON(data []byte) any {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	_ = dec.Decode(&v)
+	return v
+}
+
+// recorder keeps the response of a sub-request.
+type recorder struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *recorder) Header() http.Header { return r.header }
+
+func (r *recorder) WriteHeader(code int) {
+	if !r.wroteHeader {
+		r.status = code
+		r.wroteHeader = true
+	}
+}
+
+func (r *recorder) Write(p []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(p)
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"full-stack-assesment/internal/migrate"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/store"
+)
+
+const checkTimeout = 2 * time.Second
+
+// HealthService answers liveness and readiness probes. It is shared by
+// pointer because the startup gate is flipped once migrations have run.
+type HealthService struct {
+	db    *store.DB
+	ready atomic.Bool
+}
+
+func NewService(db *store.DB) *HealthService {
+	return &HealthService{db: db}
+}
+
+// MarkReady opens the startup gate. Until then readiness always fails.
+func (s *HealthService) MarkReady() {
+	s.ready.Store(true)
+}
+
+// Live reports that the process is serving requests.
+func (s *HealthService) Live(ctx context.Context) scheme.Health {
+	return scheme.Health{Status: scheme.Ok}
+}
+
+// Ready checks every dependency and reports whether all of them are healthy.
+func (s *HealthService) Ready(ctx context.Context) (scheme.Health, bool) {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	components := map[string]scheme.ComponentHealth{
+		"startup":  s.checkStartup(),
+		"database": s.checkDatabase(ctx),
+	}
+	// The migration table is only trustworthy once startup has finished.
+	if s.ready.Load() {
+		components["migrations"] = s.checkMigrations(ctx)
+	}
+
+	health := scheme.Health{Status: scheme.Ok, Components: &components}
+	for _, c := range components {
+		if c.Status != scheme.Ok {
+			health.Status = scheme.Unhealthy
+		}
+	}
+	return health, health.Status == scheme.Ok
+}
+
+func (s *HealthService) checkStartup() scheme.ComponentHealth {
+	if !s.ready.Load() {
+		return unhealthy("migrations have not finished", nil)
+	}
+	return scheme.ComponentHealth{Status: scheme.Ok}
+}
+
+// checkDatabase pings the read pool only, and reports its statistics. The
+// write pool has a single connection, so pinging it would wait behind any
+// long write in progress.
+func (s *HealthService) checkDatabase(ctx context.Context) scheme.ComponentHealth {
+	start := time.Now()
+	if err := s.db.Reader().PingContext(ctx); err != nil {
+		return unhealthy(err.Error(), nil)
+	}
+	stats := s.db.Reader().Stats()
+	details := map[string]interface{}{
+		"latencyMs":       time.Since(start).Milliseconds(),
+		"openConnections": stats.OpenConnections,
+		"inUse":           stats.InUse,
+	}
+	return scheme.ComponentHealth{Status: scheme.Ok, Details: &details}
+}
+
+func (s *HealthService) checkMigrations(ctx context.Context) scheme.ComponentHealth {
+	current, latest, err := migrate.Version(ctx, s.db.Reader())
+	if err != nil {
+		return unhealthy(err.Error(), nil)
+	}
+	details := map[string]interface{}{
+		"current": current,
+		"latest":  latest,
+	}
+	if current != latest {
+		return unhealthy(fmt.Sprintf("schema at version %d, want %d", current, latest), details)
+	}
+	return scheme.ComponentHealth{Status: scheme.Ok, Details: &details}
+}
+
+func unhealthy(msg string, details map[string]interface{}) scheme.ComponentHealth {
+	c := scheme.ComponentHealth{Status: scheme.Unhealthy, Message: &msg}
+	if details != nil {
+		c.Details = &details
+	}
+	return c
+}
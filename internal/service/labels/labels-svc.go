@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"full-stack-assesment/internal/apierrors"
+	repo "full-stack-assesment/internal/repo/labels"
+	"full-stack-assesment/internal/scheme"
+	projectsSvc "full-stack-assesment/internal/service/projects"
+	"full-stack-assesment/internal/telemetry"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// LabelsService manages the label catalog of each project. Putting labels on
+// tasks is left to the task service.
+type LabelsService struct {
+	repo            repo.SQLiteLabelsRepo
+	projectsService projectsSvc.ProjectsService
+}
+
+func NewService(repo repo.SQLiteLabelsRepo, projectsService projectsSvc.ProjectsService) *LabelsService {
+	return &LabelsService{
+		repo:            repo,
+		projectsService: projectsService,
+	}
+}
+
+func (s *LabelsService) CreateLabel(ctx context.Context, projectID string, newLabel scheme.NewLabel) (_ *scheme.Label, err error) {
+	ctx, span := telemetry.Start(ctx, "LabelsService.CreateLabel")
+	defer func() { span.End(err) }()
+
+	name, err := validateName(newLabel.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	label := scheme.Label{
+		Id:        types.UUID(uuid.New()),
+		ProjectId: types.UUID(uuid.MustParse(projectID)),
+		Name:      name,
+		Color:     strings.ToLower(newLabel.Color),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := s.repo.Create(ctx, label); err != nil {
+		if isNameConflict(err) {
+			return nil, apierrors.ErrLabelNameExists
+		}
+		return nil, err
+	}
+	return &label, nil
+}
+
+func (s *LabelsService) GetLabel(ctx context.Context, projectID, labelID string) (_ *scheme.Label, err error) {
+	ctx, span := telemetry.Start(ctx, "LabelsService.GetLabel")
+	defer func() { span.End(err) }()
+
+	if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+		return nil, err
+	}
+	label, err := s.repo.Get(ctx, projectID, labelID)
+	if err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+func (s *LabelsService) ListLabels(ctx context.Context, projectID string) (_ []scheme.Label, err error) {
+	ctx, span := telemetry.Start(ctx, "LabelsService.ListLabels")
+	defer func() { span.End(err) }()
+
+	if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, projectID)
+}
+
+// UpdateLabel changes the fields present in update. Tasks refer to labels by
+// ID, so they all show the new name and color, and their versions move on.
+func (s *LabelsService) UpdateLabel(ctx context.Context, projectID, labelID string, update scheme.UpdateLabel) (_ *scheme.Label, err error) {
+	ctx, span := telemetry.Start(ctx, "LabelsService.UpdateLabel")
+	defer func() { span.End(err) }()
+
+	var label *scheme.Label
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		if label, err = s.GetLabel(ctx, projectID, labelID); err != nil {
+			return err
+		}
+
+		if update.Name != nil {
+			if label.Name, err = validateName(*update.Name); err != nil {
+				return err
+			}
+		}
+		if update.Color != nil {
+			label.Color = strings.ToLower(*update.Color)
+		}
+		label.UpdatedAt = time.Now().UTC()
+
+		if err := s.repo.Update(ctx, *label); err != nil {
+			if isNameConflict(err) {
+				return apierrors.ErrLabelNameExists
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+// DeleteLabel removes a label from the catalog and from every task carrying
+// it, bumping their versions.
+func (s *LabelsService) DeleteLabel(ctx context.Context, projectID, labelID string) (err error) {
+	ctx, span := telemetry.Start(ctx, "LabelsService.DeleteLabel")
+	defer func() { span.End(err) }()
+
+	return s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+			return err
+		}
+		return s.repo.Delete(ctx, projectID, labelID, time.Now().UTC())
+	})
+}
+
+// validateName trims a label name. The spec's maxLength already bounds it.
+func validateName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if name == "" {
+		return "", apierrors.ErrLabelNameRequired
+	}
+	return name, nil
+}
+
+func isNameConflict(err error) bool {
+	errStr := strings.ToLower(err.Error())
+	return strings.Contains(errStr, "unique") && strings.Contains(errStr, "labels.name")
+}
@@ -3,8 +3,12 @@ package service
 import (
 	"context"
 	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/config"
+	"full-stack-assesment/internal/etag"
+	"full-stack-assesment/internal/pagination"
 	repo "full-stack-assesment/internal/repo/projects"
 	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/telemetry"
 	"strings"
 	"time"
 
@@ -13,20 +17,21 @@ import (
 )
 
 type ProjectsService struct {
-	repo repo.SQLiteProjectsRepo
+	repo   repo.SQLiteProjectsRepo
+	limits config.Limits
 }
 
-func NewService(repo repo.SQLiteProjectsRepo) *ProjectsService {
-	return &ProjectsService{repo: repo}
+func NewService(repo repo.SQLiteProjectsRepo, limits config.Limits) *ProjectsService {
+	return &ProjectsService{repo: repo, limits: limits}
 }
 
-func (s *ProjectsService) CreateProject(ctx context.Context, newProject scheme.NewProject) (*scheme.Project, error) {
-	name := strings.TrimSpace(newProject.Name)
-	if name == "" {
-		return nil, apierrors.ErrProjectNameRequired
-	}
-	if l := len(name); l > 128 {
-		return nil, apierrors.ErrProjectNameTooLong
+func (s *ProjectsService) CreateProject(ctx context.Context, newProject scheme.NewProject) (_ *scheme.Project, err error) {
+	ctx, span := telemetry.Start(ctx, "ProjectsService.CreateProject")
+	defer func() { span.End(err) }()
+
+	name, err := s.validateName(newProject.Name)
+	if err != nil {
+		return nil, err
 	}
 
 	id := uuid.New()
@@ -37,11 +42,11 @@ func (s *ProjectsService) CreateProject(ctx context.Context, newProject scheme.N
 		Name:      name,
 		CreatedAt: now,
 		UpdatedAt: now,
+		Version:   1,
 	}
 
-	err := s.repo.Create(ctx, created)
-	if err != nil {
-		if errStr := strings.ToLower(err.Error()); strings.Contains(errStr, "unique") && strings.Contains(errStr, "projects.name") {
+	if err := s.repo.Create(ctx, created); err != nil {
+		if isNameConflict(err) {
 			return nil, apierrors.ErrProjectNameExists
 		}
 		return nil, err
@@ -50,17 +55,137 @@ func (s *ProjectsService) CreateProject(ctx context.Context, newProject scheme.N
 	return &created, nil
 }
 
-func (s *ProjectsService) ListProject(ctx context.Context) ([]scheme.Project, error) {
-	projects, err := s.repo.List(ctx)
+func (s *ProjectsService) GetProject(ctx context.Context, projectID string) (_ *scheme.Project, err error) {
+	ctx, span := telemetry.Start(ctx, "ProjectsService.GetProject")
+	defer func() { span.End(err) }()
+
+	project, err := s.repo.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// UpdateProject renames a project. A body without a name leaves the project
+// untouched and returns its current state. With ifMatch, the project must
+// still carry one of the entity tags it lists.
+func (s *ProjectsService) UpdateProject(ctx context.Context, projectID string, update scheme.UpdateProject, ifMatch *string) (_ *scheme.Project, err error) {
+	ctx, span := telemetry.Start(ctx, "ProjectsService.UpdateProject")
+	defer func() { span.End(err) }()
+
+	project, err := s.repo.Get(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
-	return projects, nil
+	version, err := etag.Check(ifMatch, project.Version)
+	if err != nil {
+		return nil, err
+	}
+	if update.Name == nil {
+		return &project, nil
+	}
+
+	name, err := s.validateName(*update.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	project.Name = name
+	project.UpdatedAt = time.Now().UTC()
+
+	if project.Version, err = s.repo.Update(ctx, project, version); err != nil {
+		if isNameConflict(err) {
+			return nil, apierrors.ErrProjectNameExists
+		}
+		return nil, err
+	}
+	return &project, nil
 }
 
-func (s *ProjectsService) EnsureProjectExists(ctx context.Context, projectID string) error {
+// DeleteProject removes a project together with all of its tasks. With
+// ifMatch, the project must still carry one of the entity tags it lists.
+// Tasks in other projects that lose a dependency on one of its tasks have
+// their versions bumped.
+func (s *ProjectsService) DeleteProject(ctx context.Context, projectID string, ifMatch *string) (err error) {
+	ctx, span := telemetry.Start(ctx, "ProjectsService.DeleteProject")
+	defer func() { span.End(err) }()
+
+	return s.repo.InTx(ctx, func(ctx context.Context) error {
+		var version int
+		if ifMatch != nil {
+			project, err := s.repo.Get(ctx, projectID)
+			if err != nil {
+				return err
+			}
+			if version, err = etag.Check(ifMatch, project.Version); err != nil {
+				return err
+			}
+		}
+		if err := s.repo.TouchDependents(ctx, projectID, time.Now().UTC()); err != nil {
+			return err
+		}
+		return s.repo.Delete(ctx, projectID, version)
+	})
+}
+
+// projectOrder is the order of project listings: most recently updated
+// first, then by name, which is unique.
+var projectOrder = pagination.Order{{Column: "updated_at", Desc: true}, {Column: "name"}}
+
+func (s *ProjectsService) ListProject(ctx context.Context, params scheme.ListProjectsParams) (_ pagination.Page[scheme.Project], err error) {
+	ctx, span := telemetry.Start(ctx, "ProjectsService.ListProject")
+	defer func() { span.End(err) }()
+
+	query, err := pagination.ParseQuery(pagination.Params{
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		After:  params.After,
+		Before: params.Before,
+	}, projectOrder, s.limits.DefaultPageSize, s.limits.MaxPageSize)
+	if err != nil {
+		return pagination.Page[scheme.Project]{}, err
+	}
+
+	page, err := s.repo.List(ctx, projectOrder, query)
+	if err != nil {
+		return pagination.Page[scheme.Project]{}, err
+	}
+	if page.Total, err = s.repo.Count(ctx); err != nil {
+		return pagination.Page[scheme.Project]{}, err
+	}
+	return page, nil
+}
+
+func (s *ProjectsService) EnsureProjectExists(ctx context.Context, projectID string) (err error) {
+	ctx, span := telemetry.Start(ctx, "ProjectsService.EnsureProjectExists")
+	defer func() { span.End(err) }()
+
 	if err := s.repo.EnsureProjectExists(ctx, projectID); err != nil {
 		return err
 	}
 	return nil
 }
+
+// ProjectNames returns the names of the projects in ids, keyed by ID.
+func (s *ProjectsService) ProjectNames(ctx context.Context, ids []string) (_ map[string]string, err error) {
+	ctx, span := telemetry.Start(ctx, "ProjectsService.ProjectNames")
+	defer func() { span.End(err) }()
+
+	return s.repo.Names(ctx, ids)
+}
+
+func (s *ProjectsService) validateName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if name == "" {
+		return "", apierrors.ErrProjectNameRequired
+	}
+	if l := len(name); l > s.limits.ProjectNameMaxLength {
+		return "", apierrors.ErrProjectNameTooLong
+	}
+	return name, nil
+}
+
+func isNameConflict(err error) bool {
+	errStr := strings.ToLower(err.Error())
+	return strings.Contains(errStr, "unique") && strings.Contains(errStr, "projects.name")
+}
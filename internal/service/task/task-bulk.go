@@ -0,0 +1,261 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/filter"
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/telemetry"
+)
+
+// errBulkAborted stops an atomic bulk request at its first failing task, so
+// that InTx rolls everything back.
+var errBulkAborted = errors.New("bulk request aborted")
+
+// bulkTarget is one task a bulk operation acts on. A create has no ID yet.
+type bulkTarget struct {
+	index int
+	op    scheme.BulkTaskOperation
+	id    string
+}
+
+// BulkTasks runs the operations of req on the tasks of a project, in order
+// and in one transaction. Every task touched gets a result carrying the
+// status a single request would have got. In atomic mode the first failing
+// task rolls everything back and the response is not committed; in
+// bestEffort mode each task runs in a savepoint of its own, so a failure
+// undoes only that task. Errors that no single task caused, such as an
+// invalid operation, an unknown project or touching more than the configured
+// maximum of tasks, fail the request as a whole.
+func (s *TaskService) BulkTasks(ctx context.Context, projectID string, req scheme.BulkTaskRequest) (_ *scheme.BulkTaskResponse, err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.BulkTasks")
+	defer func() { span.End(err) }()
+
+	filters, err := checkBulkOperations(req.Operations)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &scheme.BulkTaskResponse{Mode: scheme.BulkModeAtomic, Results: []scheme.BulkTaskResult{}}
+	if req.Mode != nil {
+		out.Mode = *req.Mode
+	}
+
+	var pending []bulkTarget
+	cascaded := map[string]bool{}
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+			return err
+		}
+
+		touched := 0
+		for i, op := range req.Operations {
+			targets, err := s.bulkTargets(ctx, projectID, i, op, filters[i])
+			if err != nil {
+				return err
+			}
+			if touched += len(targets); touched > s.limits.BulkMaxItems {
+				return apierrors.InvalidField("operations", fmt.Sprintf("touch more than %d tasks", s.limits.BulkMaxItems))
+			}
+
+			for j, t := range targets {
+				res, err := s.runBulkTarget(ctx, projectID, t, cascaded)
+				if err != nil {
+					return err
+				}
+				out.Results = append(out.Results, res)
+				if res.Error != nil && out.Mode == scheme.BulkModeAtomic {
+					pending = append(pending, targets[j+1:]...)
+					for k := i + 1; k < len(req.Operations); k++ {
+						pending = append(pending, unresolvedTargets(k, req.Operations[k])...)
+					}
+					return errBulkAborted
+				}
+			}
+		}
+		return nil
+	})
+
+	switch {
+	case errors.Is(err, errBulkAborted):
+		abortBulk(ctx, out, pending)
+	case err != nil:
+		return nil, err
+	default:
+		out.Committed = true
+	}
+	for _, res := range out.Results {
+		if res.Error == nil {
+			out.Succeeded++
+		} else {
+			out.Failed++
+		}
+	}
+	return out, nil
+}
+
+// checkBulkOperations checks that every operation carries the members its op
+// needs, and parses the filters, so a malformed request changes nothing.
+func checkBulkOperations(ops []scheme.BulkTaskOperation) ([]*filter.Filter, error) {
+	filters := make([]*filter.Filter, len(ops))
+	for i, op := range ops {
+		field := func(name string) string { return "operations." + strconv.Itoa(i) + "." + name }
+
+		required := map[scheme.BulkTaskOp]struct {
+			name    string
+			present bool
+		}{
+			scheme.BulkOpCreate:    {"task", op.Task != nil},
+			scheme.BulkOpUpdate:    {"changes", op.Changes != nil},
+			scheme.BulkOpSetStatus: {"status", op.Status != nil},
+		}
+		if r, ok := required[op.Op]; ok && !r.present {
+			return nil, apierrors.InvalidField(field(r.name), "is required by op "+string(op.Op))
+		}
+
+		if op.Op == scheme.BulkOpCreate {
+			if op.Ids != nil || op.Filter != nil {
+				return nil, apierrors.InvalidField(field("ids"), "ids and filter do not apply to op create")
+			}
+			continue
+		}
+		if (op.Ids == nil) == (op.Filter == nil) {
+			return nil, apierrors.InvalidField(field("ids"), "exactly one of ids and filter is required by op "+string(op.Op))
+		}
+		if op.Filter != nil {
+			f, err := filter.Parse(*op.Filter, taskFilterFields)
+			if err != nil {
+				return nil, apierrors.ErrInvalidFilter.
+					WithMessage("invalid filter: " + err.Error()).
+					WithDetails(apierrors.FieldError{Field: field("filter"), Message: err.Error()})
+			}
+			filters[i] = f
+		}
+	}
+	return filters, nil
+}
+
+// bulkTargets lists the tasks operation i acts on. Filters are evaluated
+// now, so they see what earlier operations did.
+func (s *TaskService) bulkTargets(ctx context.Context, projectID string, i int, op scheme.BulkTaskOperation, f *filter.Filter) ([]bulkTarget, error) {
+	if f == nil {
+		return unresolvedTargets(i, op), nil
+	}
+	cond, args := f.SQL(time.Now())
+	ids, err := s.repo.IDs(ctx, []string{"project_id = ?", cond}, append([]any{projectID}, args...))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]bulkTarget, len(ids))
+	for j, id := range ids {
+		out[j] = bulkTarget{index: i, op: op, id: id}
+	}
+	return out, nil
+}
+
+// unresolvedTargets lists the tasks of operation i that are known without
+// running a query: the created task, the listed IDs, or, for a filter, one
+// target standing for all of them.
+func unresolvedTargets(i int, op scheme.BulkTaskOperation) []bulkTarget {
+	if op.Ids == nil {
+		return []bulkTarget{{index: i, op: op}}
+	}
+	out := make([]bulkTarget, len(*op.Ids))
+	for j, id := range *op.Ids {
+		out[j] = bulkTarget{index: i, op: op, id: id.String()}
+	}
+	return out
+}
+
+// runBulkTarget applies one operation to one task in a savepoint. Failures
+// of the task become its result; only errors of the server itself are
+// returned. cascaded holds the subtasks that deletes earlier in the request
+// removed along with their parents; deleting one of them again succeeds.
+func (s *TaskService) runBulkTarget(ctx context.Context, projectID string, t bulkTarget, cascaded map[string]bool) (scheme.BulkTaskResult, error) {
+	res := scheme.BulkTaskResult{Index: t.index, Op: t.op.Op}
+	if t.id != "" {
+		id := helpers.MustUUID(t.id)
+		res.Id = &id
+	}
+
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		var (
+			task *scheme.Task
+			err  error
+		)
+		switch t.op.Op {
+		case scheme.BulkOpCreate:
+			task, err = s.CreateTask(ctx, *t.op.Task, projectID)
+			res.Status = http.StatusCreated
+		case scheme.BulkOpUpdate:
+			task, err = s.UpdateTask(ctx, t.id, projectID, *t.op.Changes, nil)
+			res.Status = http.StatusOK
+		case scheme.BulkOpSetStatus:
+			task, err = s.UpdateTask(ctx, t.id, projectID, scheme.UpdateTask{Status: t.op.Status}, nil)
+			res.Status = http.StatusOK
+		case scheme.BulkOpDelete:
+			res.Status = http.StatusNoContent
+			if cascaded[t.id] {
+				break
+			}
+			var below []scheme.Task
+			if below, err = s.repo.Descendants(ctx, t.id); err != nil {
+				break
+			}
+			if err = s.DeleteTask(ctx, t.id, projectID, nil, scheme.SubtasksCascade); err == nil {
+				for _, d := range below {
+					cascaded[d.Id.String()] = true
+				}
+			}
+		default:
+			err = apierrors.InvalidField("op", "unknown op "+string(t.op.Op))
+		}
+		if task != nil {
+			res.Id, res.Task = &task.Id, task
+		}
+		return err
+	})
+	if err != nil {
+		apiErr := apierrors.From(err)
+		if apiErr.Status >= http.StatusInternalServerError {
+			return res, err
+		}
+		body := helpers.ErrorBody(ctx, apiErr)
+		res.Status, res.Task, res.Error = apiErr.Status, nil, &body
+	}
+	return res, nil
+}
+
+// abortBulk rewrites the results of an atomic request that rolled back:
+// every task but the failing one reports BULK_ABORTED, including the pending
+// ones that never ran.
+func abortBulk(ctx context.Context, out *scheme.BulkTaskResponse, pending []bulkTarget) {
+	aborted := helpers.ErrorBody(ctx, apierrors.ErrBulkAborted)
+	for i := range out.Results {
+		if out.Results[i].Error != nil {
+			continue
+		}
+		out.Results[i].Status = apierrors.ErrBulkAborted.Status
+		out.Results[i].Task = nil
+		out.Results[i].Error = &aborted
+		if out.Results[i].Op == scheme.BulkOpCreate {
+			// The created task was rolled back; its ID names nothing.
+			out.Results[i].Id = nil
+		}
+	}
+	for _, t := range pending {
+		res := scheme.BulkTaskResult{Index: t.index, Op: t.op.Op, Status: apierrors.ErrBulkAborted.Status, Error: &aborted}
+		if t.id != "" {
+			id := helpers.MustUUID(t.id)
+			res.Id = &id
+		}
+		out.Results = append(out.Results, res)
+	}
+}
@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/telemetry"
+)
+
+// AddBlocker records that the task blockerUUID, in any project, blocks the
+// task taskUUID, bumping the versions of both, and returns the blocked task.
+// A dependency that would let a task block itself, directly or through other
+// tasks, is refused.
+func (s *TaskService) AddBlocker(ctx context.Context, taskUUID string, projectUUID string, blockerUUID string) (_ *scheme.Task, err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.AddBlocker")
+	defer func() { span.End(err) }()
+
+	var out *scheme.Task
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.projectsService.EnsureProjectExists(ctx, projectUUID); err != nil {
+			return err
+		}
+		if _, err := s.repo.Get(ctx, taskUUID, projectUUID); err != nil {
+			return err
+		}
+		found, err := s.repo.Exists(ctx, blockerUUID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return apierrors.ErrTaskNotFound.WithMessage("blocking task not found")
+		}
+		cycle, err := s.repo.Blocks(ctx, taskUUID, blockerUUID)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return apierrors.ErrDependencyCycle
+		}
+		if err := s.repo.AddDependency(ctx, blockerUUID, taskUUID, time.Now().UTC()); err != nil {
+			return err
+		}
+		if out, err = s.repo.Get(ctx, taskUUID, projectUUID); err != nil {
+			return err
+		}
+		return s.annotate(ctx, out)
+	})
+	return out, err
+}
+
+// RemoveBlocker deletes the dependency of the task taskUUID on blockerUUID,
+// bumping the versions of both.
+func (s *TaskService) RemoveBlocker(ctx context.Context, taskUUID string, projectUUID string, blockerUUID string) (err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.RemoveBlocker")
+	defer func() { span.End(err) }()
+
+	return s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.projectsService.EnsureProjectExists(ctx, projectUUID); err != nil {
+			return err
+		}
+		if _, err := s.repo.Get(ctx, taskUUID, projectUUID); err != nil {
+			return err
+		}
+		return s.repo.RemoveDependency(ctx, blockerUUID, taskUUID, time.Now().UTC())
+	})
+}
+
+// checkBlockers refuses to start or finish a task while tasks blocking it are
+// not DONE, when blockers are enforced.
+func (s *TaskService) checkBlockers(ctx context.Context, from scheme.Task, to scheme.Task) error {
+	if !s.rules.EnforceBlockers || to.Status == from.Status || to.Status == scheme.TODO {
+		return nil
+	}
+	blockers, err := s.repo.UnfinishedBlockers(ctx, to.Id.String())
+	if err != nil {
+		return err
+	}
+	if len(blockers) == 0 {
+		return nil
+	}
+	details := make([]apierrors.FieldError, len(blockers))
+	for i, id := range blockers {
+		details[i] = apierrors.FieldError{Field: "blockedBy", Message: id + " is not DONE"}
+	}
+	return apierrors.ErrTaskBlocked.WithDetails(details...)
+}
+
+// addDependencies sets the tasks blocking each of tasks and the tasks it
+// blocks.
+func (s *TaskService) addDependencies(ctx context.Context, tasks ...*scheme.Task) error {
+	ids := make([]string, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.Id.String()
+	}
+	blockedBy, blocks, err := s.repo.Dependencies(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, t := range tasks {
+		if ids, ok := blockedBy[t.Id.String()]; ok {
+			t.BlockedBy = &ids
+		}
+		if ids, ok := blocks[t.Id.String()]; ok {
+			t.Blocks = &ids
+		}
+	}
+	return nil
+}
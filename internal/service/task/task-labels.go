@@ -0,0 +1,76 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/telemetry"
+)
+
+// AddLabel puts a label of the task's project on the task, bumping its
+// version, and returns the task.
+func (s *TaskService) AddLabel(ctx context.Context, taskUUID string, projectUUID string, labelUUID string) (_ *scheme.Task, err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.AddLabel")
+	defer func() { span.End(err) }()
+
+	var out *scheme.Task
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.projectsService.EnsureProjectExists(ctx, projectUUID); err != nil {
+			return err
+		}
+		if _, err := s.repo.Get(ctx, taskUUID, projectUUID); err != nil {
+			return err
+		}
+		found, err := s.repo.HasLabel(ctx, projectUUID, labelUUID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return apierrors.ErrLabelNotFound
+		}
+		if err := s.repo.AddLabel(ctx, taskUUID, labelUUID, time.Now().UTC()); err != nil {
+			return err
+		}
+		if out, err = s.repo.Get(ctx, taskUUID, projectUUID); err != nil {
+			return err
+		}
+		return s.annotate(ctx, out)
+	})
+	return out, err
+}
+
+// RemoveLabel takes a label off a task, bumping its version.
+func (s *TaskService) RemoveLabel(ctx context.Context, taskUUID string, projectUUID string, labelUUID string) (err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.RemoveLabel")
+	defer func() { span.End(err) }()
+
+	return s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.projectsService.EnsureProjectExists(ctx, projectUUID); err != nil {
+			return err
+		}
+		if _, err := s.repo.Get(ctx, taskUUID, projectUUID); err != nil {
+			return err
+		}
+		return s.repo.RemoveLabel(ctx, taskUUID, labelUUID, time.Now().UTC())
+	})
+}
+
+// addLabels sets the labels on each of tasks.
+func (s *TaskService) addLabels(ctx context.Context, tasks ...*scheme.Task) error {
+	ids := make([]string, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.Id.String()
+	}
+	labels, err := s.repo.Labels(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, t := range tasks {
+		if l, ok := labels[t.Id.String()]; ok {
+			t.Labels = &l
+		}
+	}
+	return nil
+}
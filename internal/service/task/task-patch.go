@@ -0,0 +1,159 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/patch"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/telemetry"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// descriptionMaxLength is the spec's maxLength of a description. Request
+// validation enforces it on bodies, but cannot see into the values of a JSON
+// Patch.
+const descriptionMaxLength = 8000
+
+// patchableFields are the members of a task a patch may change. The others
+// may be tested but must come out of the patch as they went in.
+var patchableFields = map[string]bool{"title": true, "description": true, "status": true, "parentId": true}
+
+// ParsePatch decodes a patch document of the given media type.
+func (s *TaskService) ParsePatch(mediaType string, body []byte) (patch.Patch, error) {
+	p, err := patch.Parse(mediaType, body)
+	if err != nil {
+		return nil, patchError(err)
+	}
+	return p, nil
+}
+
+// PatchTask applies p to the task as the API represents it and stores the
+// result. With ifMatch, the task must still carry one of the entity tags it
+// lists.
+func (s *TaskService) PatchTask(ctx context.Context, taskUUID string, projectUUID string, p patch.Patch, ifMatch *string) (_ *scheme.Task, err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.PatchTask")
+	defer func() { span.End(err) }()
+
+	return s.modify(ctx, taskUUID, projectUUID, ifMatch, func(task *scheme.Task) error {
+		doc, err := taskDocument(*task)
+		if err != nil {
+			return err
+		}
+		patched, err := p.Apply(doc)
+		if err != nil {
+			return patchError(err)
+		}
+		return s.applyDocument(task, doc, patched)
+	})
+}
+
+// taskDocument returns the task as decoded JSON, the form patches apply to.
+func taskDocument(task scheme.Task) (map[string]any, error) {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// applyDocument copies the patchable members of the patched document into
+// task, checking them as a create would, after making sure the patch left
+// every other member of the original alone.
+func (s *TaskService) applyDocument(task *scheme.Task, original map[string]any, patched any) error {
+	doc, ok := patched.(map[string]any)
+	if !ok {
+		return apierrors.InvalidPatch("", "the patched task must be an object")
+	}
+	for name, v := range original {
+		if patchableFields[name] {
+			continue
+		}
+		if got, ok := doc[name]; !ok || !reflect.DeepEqual(got, v) {
+			return apierrors.InvalidField(name, "is read-only")
+		}
+	}
+	for name := range doc {
+		if _, ok := original[name]; !ok && !patchableFields[name] {
+			return apierrors.InvalidField(name, "is not a task field")
+		}
+	}
+
+	rawTitle, ok := doc["title"].(string)
+	if _, present := doc["title"]; present && !ok {
+		return apierrors.InvalidField("title", "must be a string")
+	}
+	title, err := s.NormalizeTitle(rawTitle)
+	if err != nil {
+		return err
+	}
+
+	var desc *string
+	switch v := doc["description"].(type) {
+	case nil:
+	case string:
+		if len(v) > descriptionMaxLength {
+			return apierrors.InvalidField("description", "is too long")
+		}
+		desc = nonEmpty(strings.TrimSpace(v))
+	default:
+		return apierrors.InvalidField("description", "must be a string or null")
+	}
+
+	rawStatus, ok := doc["status"].(string)
+	if !ok {
+		return apierrors.ErrTaskStatusInvalid
+	}
+	status, ok := helpers.NormalizeStatus(rawStatus)
+	if !ok {
+		return apierrors.ErrTaskStatusInvalid
+	}
+
+	var parent *types.UUID
+	switch v := doc["parentId"].(type) {
+	case nil:
+	case string:
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return apierrors.InvalidField("parentId", "must be a task ID or null")
+		}
+		parent = (*types.UUID)(&id)
+	default:
+		return apierrors.InvalidField("parentId", "must be a task ID or null")
+	}
+
+	task.Title, task.Description, task.Status, task.ParentId = title, desc, scheme.TaskStatus(status), parent
+	return nil
+}
+
+// patchError maps the errors of the patch package onto the catalog.
+func patchError(err error) error {
+	var (
+		pe       *patch.Error
+		syntax   *json.SyntaxError
+		mistyped *json.UnmarshalTypeError
+	)
+	switch {
+	case errors.As(err, &pe) && errors.Is(err, patch.ErrTestFailed):
+		return apierrors.ErrPatchTestFailed.WithDetails(apierrors.FieldError{Field: pe.Path, Message: "does not hold the tested value"})
+	case errors.As(err, &pe):
+		return apierrors.InvalidPatch(pe.Path, pe.Msg)
+	case errors.Is(err, patch.ErrUnsupportedMediaType):
+		return apierrors.ErrUnsupportedMedia.WithMessage("request body must be " + patch.MergePatchType + " or " + patch.JSONPatchType)
+	case errors.As(err, &syntax), errors.As(err, &mistyped):
+		return apierrors.ErrMalformedBody
+	default:
+		return err
+	}
+}
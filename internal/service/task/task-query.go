@@ -0,0 +1,201 @@
+package repo
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/filter"
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/pagination"
+	"full-stack-assesment/internal/scheme"
+)
+
+// taskSortColumns whitelists the sort keys of task listings. Only these
+// column names ever reach ORDER BY or the keyset predicate.
+var taskSortColumns = map[string]string{
+	"createdAt": "created_at",
+	"updatedAt": "updated_at",
+	"title":     "title",
+	"status":    "status",
+}
+
+// taskFilterFields are the fields a filter expression may name, with the
+// columns they compare.
+var taskFilterFields = filter.Fields{
+	"status":      {Column: "status", Kind: filter.Enum, Values: []string{"TODO", "IN_PROGRESS", "DONE"}},
+	"title":       {Column: "title", Kind: filter.Text},
+	"description": {Column: "description", Kind: filter.Text},
+	"createdAt":   {Column: "created_at", Kind: filter.Time},
+	"updatedAt":   {Column: "updated_at", Kind: filter.Time},
+}
+
+// defaultTaskOrder lists the most recently updated tasks first.
+var defaultTaskOrder = pagination.Order{{Column: "updated_at", Desc: true}, {Column: "id", Desc: true}}
+
+// parseTaskSort turns a sort parameter such as "-createdAt,title" into an
+// order. The task ID is appended as a tie-breaker so the order is total, as
+// keyset cursors require. Errors say what is wrong with raw; callers report
+// them against the parameter or field it came from.
+func parseTaskSort(raw *string) (pagination.Order, error) {
+	if raw == nil || strings.TrimSpace(*raw) == "" {
+		return defaultTaskOrder, nil
+	}
+
+	var (
+		order pagination.Order
+		seen  = map[string]bool{}
+	)
+	for _, field := range strings.Split(*raw, ",") {
+		field = strings.TrimSpace(field)
+		desc := strings.HasPrefix(field, "-")
+		name := strings.TrimLeft(field, "+-")
+
+		column, ok := taskSortColumns[name]
+		if !ok {
+			return nil, errors.New("unknown sort key " + strconv.Quote(name) + "; use createdAt, updatedAt, title or status")
+		}
+		if seen[column] {
+			return nil, errors.New("sort key " + strconv.Quote(name) + " is repeated")
+		}
+		seen[column] = true
+		order = append(order, pagination.Key{Column: column, Desc: desc})
+	}
+	return append(order, pagination.Key{Column: "id", Desc: true}), nil
+}
+
+// CheckListQuery checks a filter expression and sort as a task listing would,
+// reporting problems as invalid request body fields. Saved views use it to
+// refuse queries that could never run.
+func (s *TaskService) CheckListQuery(filterExpr, sort *string) error {
+	if filterExpr != nil && *filterExpr != "" {
+		if _, err := filter.Parse(*filterExpr, taskFilterFields); err != nil {
+			return apierrors.InvalidField("filter", err.Error())
+		}
+	}
+	if _, err := parseTaskSort(sort); err != nil {
+		return apierrors.InvalidField("sort", err.Error())
+	}
+	return nil
+}
+
+// taskFilter builds the WHERE conditions of a task listing, apart from the
+// projects it covers. Conditions are fixed SQL fragments; every value the
+// client sent travels as an argument.
+func taskFilter(params scheme.ListTasksParams) ([]string, []any, error) {
+	var (
+		where []string
+		args  []any
+	)
+
+	if params.TopLevel != nil && *params.TopLevel {
+		where = append(where, "parent_id IS NULL")
+	}
+	if params.Label != nil && len(*params.Label) > 0 {
+		cond, condArgs, err := labelFilter(*params.Label, params.LabelMatch)
+		if err != nil {
+			return nil, nil, err
+		}
+		where = append(where, cond)
+		args = append(args, condArgs...)
+	}
+	if params.Status != nil && len(*params.Status) > 0 {
+		marks := make([]string, 0, len(*params.Status))
+		for _, st := range *params.Status {
+			norm, ok := helpers.NormalizeStatus(string(st))
+			if !ok {
+				return nil, nil, apierrors.InvalidParameter("status", "invalid status; use TODO|IN_PROGRESS|DONE")
+			}
+			marks = append(marks, "?")
+			args = append(args, norm)
+		}
+		where = append(where, "status IN ("+strings.Join(marks, ", ")+")")
+	}
+	if params.Q != nil {
+		if q := strings.TrimSpace(*params.Q); q != "" {
+			where = append(where, `title LIKE ? ESCAPE '\'`)
+			args = append(args, helpers.ContainsPattern(q))
+		}
+	}
+	if params.Description != nil {
+		if q := strings.TrimSpace(*params.Description); q != "" {
+			where = append(where, `description LIKE ? ESCAPE '\'`)
+			args = append(args, helpers.ContainsPattern(q))
+		}
+	}
+
+	ranges := []struct {
+		column, from, to string
+		after, before    *time.Time
+	}{
+		{"created_at", "createdAfter", "createdBefore", params.CreatedAfter, params.CreatedBefore},
+		{"updated_at", "updatedAfter", "updatedBefore", params.UpdatedAfter, params.UpdatedBefore},
+	}
+	for _, r := range ranges {
+		if r.after != nil && r.before != nil && !r.after.Before(*r.before) {
+			return nil, nil, apierrors.InvalidParameter(r.to, "must be later than "+r.from)
+		}
+		if r.after != nil {
+			where = append(where, r.column+" >= ?")
+			args = append(args, helpers.FormatTime(*r.after))
+		}
+		if r.before != nil {
+			where = append(where, r.column+" < ?")
+			args = append(args, helpers.FormatTime(*r.before))
+		}
+	}
+	if params.Filter != nil {
+		f, err := filter.Parse(*params.Filter, taskFilterFields)
+		if err != nil {
+			return nil, nil, apierrors.InvalidFilter(err.Error())
+		}
+		cond, condArgs := f.SQL(time.Now())
+		where = append(where, cond)
+		args = append(args, condArgs...)
+	}
+	return where, args, nil
+}
+
+// labelFilter matches the tasks carrying any, or with LabelMatchAll all, of
+// the labels named. Label names compare without regard to case, and a task
+// only carries labels of its own project. A blank name is refused rather than
+// left to match nothing.
+func labelFilter(names []string, match *scheme.LabelMatch) (string, []any, error) {
+	seen := map[string]bool{}
+	var (
+		marks []string
+		args  []any
+	)
+	for _, name := range names {
+		key := strings.ToLower(strings.TrimSpace(name))
+		if key == "" {
+			return "", nil, apierrors.InvalidParameter("label", "must not be blank")
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		marks = append(marks, "?")
+		args = append(args, key)
+	}
+	cond := `id IN (
+		SELECT tl.task_id FROM task_labels tl JOIN labels l ON l.id = tl.label_id
+		WHERE l.name IN (` + strings.Join(marks, ", ") + `)`
+	if match != nil && *match == scheme.LabelMatchAll {
+		cond += ` GROUP BY tl.task_id HAVING COUNT(*) = ?`
+		args = append(args, len(marks))
+	}
+	return cond + `)`, args, nil
+}
+
+// projectsFilter limits a task listing to the projects in ids.
+func projectsFilter(ids []string) (string, []any) {
+	marks := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		marks[i], args[i] = "?", id
+	}
+	return "project_id IN (" + strings.Join(marks, ", ") + ")", args
+}
@@ -0,0 +1,195 @@
+package repo
+
+import (
+	"context"
+	"html"
+	"strings"
+	"unicode"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/pagination"
+	repo "full-stack-assesment/internal/repo/task"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/telemetry"
+)
+
+// SearchTasks runs a full-text search over task titles and descriptions, in
+// the project given by params or across all of them.
+func (s *TaskService) SearchTasks(ctx context.Context, params scheme.SearchTasksParams) (_ pagination.Page[scheme.TaskSearchHit], err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.SearchTasks")
+	defer func() { span.End(err) }()
+
+	match, err := ftsQuery(params.Q)
+	if err != nil {
+		return pagination.Page[scheme.TaskSearchHit]{}, err
+	}
+
+	var projectID string
+	if params.ProjectId != nil {
+		projectID = params.ProjectId.String()
+		if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+			return pagination.Page[scheme.TaskSearchHit]{}, err
+		}
+	}
+
+	// Hits are ranked, not keyed, so search pages by offset only.
+	query, err := pagination.ParseQuery(pagination.Params{
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	}, nil, s.limits.DefaultPageSize, s.limits.MaxPageSize)
+	if err != nil {
+		return pagination.Page[scheme.TaskSearchHit]{}, err
+	}
+
+	hits, err := s.repo.Search(ctx, match, projectID, query.Limit, query.Offset)
+	if err != nil {
+		return pagination.Page[scheme.TaskSearchHit]{}, err
+	}
+	page := pagination.Page[scheme.TaskSearchHit]{
+		Items:  make([]scheme.TaskSearchHit, len(hits)),
+		Limit:  query.Limit,
+		Offset: query.Offset,
+	}
+	for i, h := range hits {
+		page.Items[i] = scheme.TaskSearchHit{
+			Task:  h.Task,
+			Score: h.Score,
+			Title: markHighlights(h.Title),
+		}
+		if h.Task.Description != nil {
+			snippet := markHighlights(h.Snippet)
+			page.Items[i].Snippet = &snippet
+		}
+	}
+	tasks := make([]*scheme.Task, len(page.Items))
+	for i := range page.Items {
+		tasks[i] = &page.Items[i].Task
+	}
+	if err := s.annotate(ctx, tasks...); err != nil {
+		return pagination.Page[scheme.TaskSearchHit]{}, err
+	}
+	if page.Total, err = s.repo.SearchCount(ctx, match, projectID); err != nil {
+		return pagination.Page[scheme.TaskSearchHit]{}, err
+	}
+	return page, nil
+}
+
+// markHighlights escapes highlighted text for HTML and turns the repository's
+// highlight markers into <mark> elements.
+func markHighlights(s string) string {
+	s = html.EscapeString(s)
+	s = strings.ReplaceAll(s, repo.HighlightStart, "<mark>")
+	return strings.ReplaceAll(s, repo.HighlightEnd, "</mark>")
+}
+
+type searchTerm struct {
+	text    string
+	quoted  bool
+	prefix  bool
+	exclude bool
+}
+
+// ftsQuery translates the q parameter of a task search into an FTS5 MATCH
+// expression. Terms are ANDed unless separated by OR; a trailing * makes a
+// prefix query, a leading - excludes the term and double quotes make a
+// phrase. Every term is emitted as a quoted FTS5 string, so nothing the
+// client sends is read as FTS5 syntax such as column filters or NEAR.
+func ftsQuery(raw string) (string, error) {
+	var (
+		groups  [][]string
+		current []string
+		exclude []string
+	)
+	for _, t := range scanSearchTerms(raw) {
+		if t.text == "OR" && !t.quoted && !t.prefix && !t.exclude {
+			if len(current) > 0 {
+				groups = append(groups, current)
+				current = nil
+			}
+			continue
+		}
+		if !strings.ContainsFunc(t.text, isWordRune) {
+			continue
+		}
+		term := `"` + t.text + `"`
+		if t.prefix {
+			term += "*"
+		}
+		if t.exclude {
+			exclude = append(exclude, term)
+		} else {
+			current = append(current, term)
+		}
+	}
+	if len(current) > 0 {
+		groups = append(groups, current)
+	}
+
+	if len(groups) == 0 {
+		if len(exclude) > 0 {
+			return "", apierrors.InvalidParameter("q", "must contain a word to search for besides excluded ones")
+		}
+		return "", apierrors.InvalidParameter("q", "must contain a word to search for")
+	}
+
+	alts := make([]string, len(groups))
+	for i, g := range groups {
+		alts[i] = strings.Join(g, " AND ")
+	}
+	expr := alts[0]
+	if len(alts) > 1 {
+		expr = "(" + strings.Join(alts, ") OR (") + ")"
+	}
+	for _, term := range exclude {
+		expr = "(" + expr + ") NOT " + term
+	}
+	return expr, nil
+}
+
+// scanSearchTerms splits q into whitespace-separated terms and quoted
+// phrases. An unterminated phrase runs to the end of q. The terms never
+// contain a double quote.
+func scanSearchTerms(q string) []searchTerm {
+	var (
+		out []searchTerm
+		rs  = []rune(q)
+	)
+	for i := 0; i < len(rs); {
+		if unicode.IsSpace(rs[i]) {
+			i++
+			continue
+		}
+		var t searchTerm
+		if rs[i] == '-' {
+			t.exclude = true
+			i++
+		}
+		if i < len(rs) && rs[i] == '"' {
+			end := i + 1
+			for end < len(rs) && rs[end] != '"' {
+				end++
+			}
+			t.text, t.quoted = string(rs[i+1:end]), true
+			i = min(end+1, len(rs))
+			if i < len(rs) && rs[i] == '*' {
+				t.prefix = true
+				i++
+			}
+		} else {
+			end := i
+			for end < len(rs) && !unicode.IsSpace(rs[end]) && rs[end] != '"' {
+				end++
+			}
+			word := string(rs[i:end])
+			t.text = strings.TrimRight(word, "*")
+			t.prefix = t.text != word && t.text != ""
+			i = end
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -2,14 +2,19 @@ package repo
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"time"
 
 	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/config"
+	"full-stack-assesment/internal/etag"
 	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/pagination"
 	repo "full-stack-assesment/internal/repo/task"
 	"full-stack-assesment/internal/scheme"
 	projectsSvc "full-stack-assesment/internal/service/projects"
+	"full-stack-assesment/internal/telemetry"
 
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
@@ -18,124 +23,423 @@ import (
 type TaskService struct {
 	projectsService projectsSvc.ProjectsService
 	repo            repo.SQLiteTaskRepo
+	limits          config.Limits
+	rules           config.Tasks
 }
 
-func NewService(repo repo.SQLiteTaskRepo, projectsService projectsSvc.ProjectsService) *TaskService {
+func NewService(repo repo.SQLiteTaskRepo, projectsService projectsSvc.ProjectsService, limits config.Limits, rules config.Tasks) *TaskService {
 	return &TaskService{
 		repo:            repo,
 		projectsService: projectsService,
+		limits:          limits,
+		rules:           rules,
 	}
 }
 
-func (s *TaskService) CreateTask(ctx context.Context, newTask scheme.NewTask, projectID string) (*scheme.Task, error) {
-
-	title := strings.TrimSpace(newTask.Title)
+// NormalizeTitle trims a task title and checks it against the configured limits.
+func (s *TaskService) NormalizeTitle(raw string) (string, error) {
+	title := strings.TrimSpace(raw)
 	if title == "" {
-		return nil, apierrors.ErrorTaskTitleNotFound
+		return "", apierrors.ErrTaskTitleRequired
 	}
-	if len(title) > 200 {
-		return nil, apierrors.ErrTaskTitleTooLong
+	if len(title) > s.limits.TitleMaxLength {
+		return "", apierrors.ErrTaskTitleTooLong
+	}
+	return title, nil
+}
+
+func (s *TaskService) CreateTask(ctx context.Context, newTask scheme.NewTask, projectID string) (_ *scheme.Task, err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.CreateTask")
+	defer func() { span.End(err) }()
+
+	title, err := s.NormalizeTitle(newTask.Title)
+	if err != nil {
+		return nil, err
 	}
 	status := "TODO"
 	if newTask.Status != nil {
 		if norm, ok := helpers.NormalizeStatus(string(*newTask.Status)); ok {
 			status = norm
 		} else {
-			return nil, apierrors.ErrorTaskStatusInvalid
+			return nil, apierrors.ErrTaskStatusInvalid
 		}
 	}
 
-	if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
-		return nil, err
-	}
-
 	id := uuid.New()
 	now := time.Now().UTC()
 
 	task := scheme.Task{
 		Id:          types.UUID(id),
 		ProjectId:   helpers.MustUUID(projectID),
-		Title:       newTask.Title,
+		ParentId:    newTask.ParentId,
+		Title:       title,
 		Description: newTask.Description,
 		Status:      scheme.TaskStatus(status),
 		CreatedAt:   now,
 		UpdatedAt:   now,
+		Version:     1,
 	}
 
-	if err := s.repo.Create(ctx, task); err != nil {
+	// A subtask counts towards the progress of every task above it.
+	err = s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+			return err
+		}
+		if newTask.ParentId == nil {
+			return s.repo.Create(ctx, task)
+		}
+		if err := s.checkParent(ctx, projectID, "", newTask.ParentId.String()); err != nil {
+			return err
+		}
+		if err := s.repo.Create(ctx, task); err != nil {
+			return err
+		}
+		return s.repo.TouchAncestors(ctx, task.Id.String(), now)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return &task, nil
 }
 
-func (s *TaskService) GetTask(ctx context.Context, taskUUID string, projectUUID string) (*scheme.Task, error) {
+func (s *TaskService) GetTask(ctx context.Context, taskUUID string, projectUUID string) (_ *scheme.Task, err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.GetTask")
+	defer func() { span.End(err) }()
+
 	task, err := s.repo.Get(ctx, taskUUID, projectUUID)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.annotate(ctx, task); err != nil {
+		return nil, err
+	}
 	return task, nil
 }
 
-func (s *TaskService) ListTasks(ctx context.Context, projectId string, params scheme.ListTasksParams) ([]scheme.Task, error) {
+func (s *TaskService) ListTasks(ctx context.Context, projectId string, params scheme.ListTasksParams) (_ pagination.Page[scheme.Task], err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.ListTasks")
+	defer func() { span.End(err) }()
 
 	if err := s.projectsService.EnsureProjectExists(ctx, projectId); err != nil {
-		return []scheme.Task{}, err
+		return pagination.Page[scheme.Task]{}, err
 	}
 
+	return s.listTasks(ctx, []string{"project_id = ?"}, []any{projectId}, params)
+}
+
+// ListAllTasks lists tasks across projects, or across the projects in
+// params.ProjectId, and adds project names when params.Embed asks for them.
+func (s *TaskService) ListAllTasks(ctx context.Context, params scheme.ListAllTasksParams) (_ pagination.Page[scheme.ProjectTask], err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.ListAllTasks")
+	defer func() { span.End(err) }()
+
 	var (
-		where  = []string{"project_id = ?"}
-		args   = []any{projectId}
-		limit  = 50
-		offset = 0
+		where []string
+		args  []any
 	)
+	if params.ProjectId != nil && len(*params.ProjectId) > 0 {
+		ids := make([]string, len(*params.ProjectId))
+		for i, id := range *params.ProjectId {
+			ids[i] = id.String()
+		}
+		cond, condArgs := projectsFilter(ids)
+		where, args = []string{cond}, condArgs
+	}
 
-	if params.Status != nil {
-		if norm, ok := helpers.NormalizeStatus(string(*params.Status)); ok {
-			where = append(where, "status = ?")
-			args = append(args, norm)
-		} else {
-			return []scheme.Task{}, apierrors.ErrorTaskStatusInvalid
+	tasks, err := s.listTasks(ctx, where, args, scheme.ListTasksParams{
+		TopLevel:      params.TopLevel,
+		Label:         params.Label,
+		LabelMatch:    params.LabelMatch,
+		Status:        params.Status,
+		Q:             params.Q,
+		Description:   params.Description,
+		CreatedAfter:  params.CreatedAfter,
+		CreatedBefore: params.CreatedBefore,
+		UpdatedAfter:  params.UpdatedAfter,
+		UpdatedBefore: params.UpdatedBefore,
+		Filter:        params.Filter,
+		Sort:          params.Sort,
+		Limit:         params.Limit,
+		Offset:        params.Offset,
+		After:         params.After,
+		Before:        params.Before,
+	})
+	if err != nil {
+		return pagination.Page[scheme.ProjectTask]{}, err
+	}
+
+	page := pagination.Page[scheme.ProjectTask]{
+		Items:  make([]scheme.ProjectTask, len(tasks.Items)),
+		Limit:  tasks.Limit,
+		Offset: tasks.Offset,
+		Total:  tasks.Total,
+		Next:   tasks.Next,
+		Prev:   tasks.Prev,
+	}
+	for i, t := range tasks.Items {
+		page.Items[i] = scheme.ProjectTask{
+			Id:          t.Id,
+			ProjectId:   t.ProjectId,
+			ParentId:    t.ParentId,
+			Progress:    t.Progress,
+			BlockedBy:   t.BlockedBy,
+			Blocks:      t.Blocks,
+			Labels:      t.Labels,
+			Title:       t.Title,
+			Description: t.Description,
+			Status:      t.Status,
+			CreatedAt:   t.CreatedAt,
+			UpdatedAt:   t.UpdatedAt,
+			Version:     t.Version,
 		}
 	}
-	if params.Q != nil {
-		q := strings.TrimSpace(*params.Q)
-		if q != "" {
-			where = append(where, "title LIKE ?")
-			args = append(args, "%"+q+"%")
+
+	if params.Embed != nil && slices.Contains(*params.Embed, scheme.ListAllTasksParamsEmbedProject) {
+		ids := make([]string, 0, len(page.Items))
+		for _, t := range page.Items {
+			ids = append(ids, t.ProjectId.String())
+		}
+		slices.Sort(ids)
+		names, err := s.projectsService.ProjectNames(ctx, slices.Compact(ids))
+		if err != nil {
+			return pagination.Page[scheme.ProjectTask]{}, err
+		}
+		for i := range page.Items {
+			if name, ok := names[page.Items[i].ProjectId.String()]; ok {
+				page.Items[i].ProjectName = &name
+			}
 		}
 	}
-	if params.Limit != nil {
-		limit = helpers.ClampInt(*params.Limit, 1, 200, 50)
+	return page, nil
+}
+
+// listTasks returns the page of the tasks matching the projects condition
+// where and the filters, sort and paging in params.
+func (s *TaskService) listTasks(ctx context.Context, where []string, args []any, params scheme.ListTasksParams) (pagination.Page[scheme.Task], error) {
+	filter, filterArgs, err := taskFilter(params)
+	if err != nil {
+		return pagination.Page[scheme.Task]{}, err
+	}
+	where = append(where, filter...)
+	args = append(args, filterArgs...)
+
+	order, err := parseTaskSort(params.Sort)
+	if err != nil {
+		return pagination.Page[scheme.Task]{}, apierrors.InvalidParameter("sort", err.Error())
+	}
+
+	query, err := pagination.ParseQuery(pagination.Params{
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		After:  params.After,
+		Before: params.Before,
+	}, order, s.limits.DefaultPageSize, s.limits.MaxPageSize)
+	if err != nil {
+		return pagination.Page[scheme.Task]{}, err
+	}
+
+	page, err := s.repo.List(ctx, where, args, order, query)
+	if err != nil {
+		return pagination.Page[scheme.Task]{}, err
+	}
+	if page.Total, err = s.repo.Count(ctx, where, args); err != nil {
+		return pagination.Page[scheme.Task]{}, err
 	}
-	if params.Offset != nil && *params.Offset >= 0 {
-		offset = *params.Offset
+	items := make([]*scheme.Task, len(page.Items))
+	for i := range page.Items {
+		items[i] = &page.Items[i]
 	}
+	if err := s.annotate(ctx, items...); err != nil {
+		return pagination.Page[scheme.Task]{}, err
+	}
+	return page, nil
+}
+
+// DeleteTask removes a task with its subtasks, or, with SubtasksPromote,
+// moves the subtasks up to the task's parent first. With ifMatch, the task
+// must still carry one of the entity tags it lists. The tasks above it, and
+// tasks that lose a dependency on a removed task, have their versions bumped.
+func (s *TaskService) DeleteTask(ctx context.Context, taskUUID string, projectUUID string, ifMatch *string, subtasks scheme.SubtaskDeletion) (err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.DeleteTask")
+	defer func() { span.End(err) }()
 
-	args = append(args, limit, offset)
+	return s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.projectsService.EnsureProjectExists(ctx, projectUUID); err != nil {
+			return apierrors.ErrProjectNotFound
+		}
+		var version int
+		now := time.Now().UTC()
+		if ifMatch != nil || subtasks == scheme.SubtasksPromote {
+			task, err := s.repo.Get(ctx, taskUUID, projectUUID)
+			if err != nil {
+				return err
+			}
+			if version, err = etag.Check(ifMatch, task.Version); err != nil {
+				return err
+			}
+			if subtasks == scheme.SubtasksPromote {
+				if err := s.repo.Promote(ctx, taskUUID, task.ParentId, now); err != nil {
+					return err
+				}
+			}
+		}
+		if err := s.repo.TouchDependents(ctx, taskUUID, now); err != nil {
+			return err
+		}
+		if err := s.repo.TouchAncestors(ctx, taskUUID, now); err != nil {
+			return err
+		}
+		return s.repo.Delete(ctx, taskUUID, projectUUID, version)
+	})
+}
 
-	tasks, err := s.repo.List(ctx, offset, limit, where, args)
+// UpdateTask changes the fields present in update and returns the result.
+// An empty description clears it. With ifMatch, the task must still carry
+// one of the entity tags it lists.
+func (s *TaskService) UpdateTask(ctx context.Context, taskUUID string, projectUUID string, update scheme.UpdateTask, ifMatch *string) (_ *scheme.Task, err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.UpdateTask")
+	defer func() { span.End(err) }()
+
+	return s.modify(ctx, taskUUID, projectUUID, ifMatch, func(task *scheme.Task) error {
+		if update.Title != nil {
+			title, err := s.NormalizeTitle(*update.Title)
+			if err != nil {
+				return err
+			}
+			task.Title = title
+		}
+		if update.Description != nil {
+			task.Description = nonEmpty(strings.TrimSpace(*update.Description))
+		}
+		if update.Status != nil {
+			norm, ok := helpers.NormalizeStatus(string(*update.Status))
+			if !ok {
+				return apierrors.ErrTaskStatusInvalid
+			}
+			task.Status = scheme.TaskStatus(norm)
+		}
+		if update.ParentId != nil {
+			if *update.ParentId == "" {
+				task.ParentId = nil
+			} else {
+				parent, err := uuid.Parse(*update.ParentId)
+				if err != nil {
+					return apierrors.InvalidField("parentId", "must be a task ID or empty")
+				}
+				task.ParentId = (*types.UUID)(&parent)
+			}
+		}
+		return nil
+	})
+}
+
+// modify reads a task, lets change edit a copy of it, and writes the copy
+// back, all in one transaction. With ifMatch, the task must carry one of the
+// entity tags it lists. A change that leaves the task as it was writes
+// nothing and returns the task unchanged. A new parent is checked before
+// anything is written.
+func (s *TaskService) modify(ctx context.Context, taskUUID string, projectUUID string, ifMatch *string, change func(task *scheme.Task) error) (*scheme.Task, error) {
+	var out *scheme.Task
+	err := s.repo.InTx(ctx, func(ctx context.Context) error {
+		if err := s.projectsService.EnsureProjectExists(ctx, projectUUID); err != nil {
+			return err
+		}
+		task, err := s.repo.Get(ctx, taskUUID, projectUUID)
+		if err != nil {
+			return err
+		}
+		version, err := etag.Check(ifMatch, task.Version)
+		if err != nil {
+			return err
+		}
+		if err := s.annotate(ctx, task); err != nil {
+			return err
+		}
+
+		next := *task
+		if err := change(&next); err != nil {
+			return err
+		}
+		moved := uuidString(next.ParentId) != uuidString(task.ParentId)
+		if !moved && next.Title == task.Title && next.Status == task.Status && deref(next.Description) == deref(task.Description) {
+			out = task
+			return nil
+		}
+		if moved && next.ParentId != nil {
+			if err := s.checkParent(ctx, projectUUID, taskUUID, next.ParentId.String()); err != nil {
+				return err
+			}
+		}
+		if err := s.checkBlockers(ctx, *task, next); err != nil {
+			return err
+		}
+
+		// The tasks above count the task towards their progress: moving it
+		// changes that for the old and the new ones, finishing or reopening it
+		// for all of them.
+		next.UpdatedAt = time.Now().UTC()
+		rollup := moved || (next.Status == scheme.DONE) != (task.Status == scheme.DONE)
+		if moved {
+			if err := s.repo.TouchAncestors(ctx, taskUUID, next.UpdatedAt); err != nil {
+				return err
+			}
+		}
+		if err := s.repo.Update(ctx, next, version); err != nil {
+			return err
+		}
+		if rollup {
+			if err := s.repo.TouchAncestors(ctx, taskUUID, next.UpdatedAt); err != nil {
+				return err
+			}
+		}
+		if out, err = s.repo.Get(ctx, taskUUID, projectUUID); err != nil {
+			return err
+		}
+		return s.annotate(ctx, out)
+	})
 	if err != nil {
-		return []scheme.Task{}, err
+		return nil, err
 	}
+	return out, nil
+}
 
-	return tasks, nil
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
 }
 
-func (s *TaskService) DeleteTask(ctx context.Context, taskUUID string, projectUUID string) error {
-	if err := s.projectsService.EnsureProjectExists(ctx, projectUUID); err != nil {
-		return apierrors.ErrProjectNotFound
+// annotate sets the members of tasks that are not stored with them: the
+// progress of their subtasks, their dependencies and their labels.
+func (s *TaskService) annotate(ctx context.Context, tasks ...*scheme.Task) error {
+	if err := s.addProgress(ctx, tasks...); err != nil {
+		return err
 	}
-	if err := s.repo.Delete(ctx, taskUUID, projectUUID); err != nil {
+	if err := s.addDependencies(ctx, tasks...); err != nil {
 		return err
 	}
-	return nil
+	return s.addLabels(ctx, tasks...)
 }
 
-func (s *TaskService) UpdateTask(ctx context.Context, args []any, set []string) error {
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
 
-	if err := s.repo.Update(ctx, args, set); err != nil {
-		return err
+func uuidString(id *types.UUID) string {
+	if id == nil {
+		return ""
 	}
-	return nil
+	return id.String()
+}
+
+// CountByStatus reports how many tasks each project has in each status.
+func (s *TaskService) CountByStatus(ctx context.Context) (_ []repo.StatusCount, err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.CountByStatus")
+	defer func() { span.End(err) }()
 
+	return s.repo.CountByStatus(ctx)
 }
@@ -0,0 +1,134 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/pagination"
+	"full-stack-assesment/internal/scheme"
+	"full-stack-assesment/internal/telemetry"
+)
+
+// ListTaskChildren lists the direct subtasks of a task, filtered, sorted and
+// paged as ListTasks would.
+func (s *TaskService) ListTaskChildren(ctx context.Context, taskUUID string, projectUUID string, params scheme.ListTaskChildrenParams) (_ pagination.Page[scheme.Task], err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.ListTaskChildren")
+	defer func() { span.End(err) }()
+
+	if err := s.projectsService.EnsureProjectExists(ctx, projectUUID); err != nil {
+		return pagination.Page[scheme.Task]{}, err
+	}
+	if _, err := s.repo.Get(ctx, taskUUID, projectUUID); err != nil {
+		return pagination.Page[scheme.Task]{}, err
+	}
+
+	return s.listTasks(ctx, []string{"project_id = ?", "parent_id = ?"}, []any{projectUUID, taskUUID}, scheme.ListTasksParams{
+		Status: params.Status,
+		Filter: params.Filter,
+		Sort:   params.Sort,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		After:  params.After,
+		Before: params.Before,
+	})
+}
+
+// GetTaskTree returns a task with all its subtasks nested under it, oldest
+// first at each level.
+func (s *TaskService) GetTaskTree(ctx context.Context, taskUUID string, projectUUID string) (_ *scheme.TaskTree, err error) {
+	ctx, span := telemetry.Start(ctx, "TaskService.GetTaskTree")
+	defer func() { span.End(err) }()
+
+	if err := s.projectsService.EnsureProjectExists(ctx, projectUUID); err != nil {
+		return nil, err
+	}
+	root, err := s.repo.Get(ctx, taskUUID, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	below, err := s.repo.Descendants(ctx, taskUUID)
+	if err != nil {
+		return nil, err
+	}
+	all := []*scheme.Task{root}
+	for i := range below {
+		all = append(all, &below[i])
+	}
+	if err := s.addDependencies(ctx, all...); err != nil {
+		return nil, err
+	}
+	if err := s.addLabels(ctx, all...); err != nil {
+		return nil, err
+	}
+
+	children := map[string][]scheme.Task{}
+	for _, t := range below {
+		parent := t.ParentId.String()
+		children[parent] = append(children[parent], t)
+	}
+	var build func(t scheme.Task) scheme.TaskTree
+	build = func(t scheme.Task) scheme.TaskTree {
+		node := scheme.TaskTree{Task: t, Children: []scheme.TaskTree{}}
+		var progress scheme.TaskProgress
+		for _, c := range children[t.Id.String()] {
+			child := build(c)
+			node.Children = append(node.Children, child)
+			progress.Total++
+			if c.Status == scheme.DONE {
+				progress.Done++
+			}
+			if child.Task.Progress != nil {
+				progress.Total += child.Task.Progress.Total
+				progress.Done += child.Task.Progress.Done
+			}
+		}
+		if progress.Total > 0 {
+			node.Task.Progress = &progress
+		}
+		return node
+	}
+	tree := build(*root)
+	return &tree, nil
+}
+
+// checkParent makes sure parentUUID can be the parent of the task taskUUID,
+// or of a new task when taskUUID is empty: it must be a task of the same
+// project and must not be the task itself or one of its subtasks.
+func (s *TaskService) checkParent(ctx context.Context, projectUUID string, taskUUID string, parentUUID string) error {
+	if _, err := s.repo.Get(ctx, parentUUID, projectUUID); err != nil {
+		if errors.Is(err, apierrors.ErrTaskNotFound) {
+			return apierrors.InvalidField("parentId", "must be a task of the same project")
+		}
+		return err
+	}
+	if taskUUID == "" {
+		return nil
+	}
+	cycle, err := s.repo.IsAncestor(ctx, taskUUID, parentUUID)
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return apierrors.InvalidField("parentId", "cannot be the task itself or one of its subtasks")
+	}
+	return nil
+}
+
+// addProgress sets the progress of the tasks that have subtasks.
+func (s *TaskService) addProgress(ctx context.Context, tasks ...*scheme.Task) error {
+	ids := make([]string, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.Id.String()
+	}
+	progress, err := s.repo.Progress(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, t := range tasks {
+		if p, ok := progress[t.Id.String()]; ok {
+			t.Progress = &p
+		}
+	}
+	return nil
+}
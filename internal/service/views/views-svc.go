@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"full-stack-assesment/internal/apierrors"
+	"full-stack-assesment/internal/config"
+	"full-stack-assesment/internal/pagination"
+	repo "full-stack-assesment/internal/repo/views"
+	"full-stack-assesment/internal/scheme"
+	projectsSvc "full-stack-assesment/internal/service/projects"
+	taskSvc "full-stack-assesment/internal/service/task"
+	"full-stack-assesment/internal/telemetry"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// defaultColumns are the columns of views saved without any.
+var defaultColumns = []scheme.ViewColumn{scheme.ViewColumnTitle, scheme.ViewColumnStatus, scheme.ViewColumnUpdatedAt}
+
+// ViewsService manages saved views: named task listings of a project.
+type ViewsService struct {
+	repo            repo.SQLiteViewsRepo
+	projectsService projectsSvc.ProjectsService
+	tasksService    taskSvc.TaskService
+	limits          config.Limits
+}
+
+func NewService(repo repo.SQLiteViewsRepo, projectsService projectsSvc.ProjectsService, tasksService taskSvc.TaskService, limits config.Limits) *ViewsService {
+	return &ViewsService{
+		repo:            repo,
+		projectsService: projectsService,
+		tasksService:    tasksService,
+		limits:          limits,
+	}
+}
+
+func (s *ViewsService) CreateView(ctx context.Context, projectID string, newView scheme.NewView) (_ *scheme.View, err error) {
+	ctx, span := telemetry.Start(ctx, "ViewsService.CreateView")
+	defer func() { span.End(err) }()
+
+	name, err := s.validateName(newView.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tasksService.CheckListQuery(newView.Filter, newView.Sort); err != nil {
+		return nil, err
+	}
+	if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	view := scheme.View{
+		Id:        types.UUID(uuid.New()),
+		ProjectId: types.UUID(uuid.MustParse(projectID)),
+		Name:      name,
+		Filter:    newView.Filter,
+		Sort:      newView.Sort,
+		Columns:   defaultColumns,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if newView.Columns != nil {
+		view.Columns = *newView.Columns
+	}
+
+	if err := s.repo.Create(ctx, view); err != nil {
+		if isNameConflict(err) {
+			return nil, apierrors.ErrViewNameExists
+		}
+		return nil, err
+	}
+	return &view, nil
+}
+
+func (s *ViewsService) GetView(ctx context.Context, projectID, viewID string) (_ *scheme.View, err error) {
+	ctx, span := telemetry.Start(ctx, "ViewsService.GetView")
+	defer func() { span.End(err) }()
+
+	if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+		return nil, err
+	}
+	view, err := s.repo.Get(ctx, projectID, viewID)
+	if err != nil {
+		return nil, err
+	}
+	return &view, nil
+}
+
+func (s *ViewsService) ListViews(ctx context.Context, projectID string) (_ []scheme.View, err error) {
+	ctx, span := telemetry.Start(ctx, "ViewsService.ListViews")
+	defer func() { span.End(err) }()
+
+	if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, projectID)
+}
+
+// UpdateView changes the fields present in update. An empty filter or sort
+// clears it.
+func (s *ViewsService) UpdateView(ctx context.Context, projectID, viewID string, update scheme.UpdateView) (_ *scheme.View, err error) {
+	ctx, span := telemetry.Start(ctx, "ViewsService.UpdateView")
+	defer func() { span.End(err) }()
+
+	view, err := s.GetView(ctx, projectID, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Name != nil {
+		if view.Name, err = s.validateName(*update.Name); err != nil {
+			return nil, err
+		}
+	}
+	if update.Filter != nil {
+		view.Filter = nonEmpty(*update.Filter)
+	}
+	if update.Sort != nil {
+		view.Sort = nonEmpty(*update.Sort)
+	}
+	if update.Columns != nil {
+		view.Columns = *update.Columns
+	}
+	if err := s.tasksService.CheckListQuery(view.Filter, view.Sort); err != nil {
+		return nil, err
+	}
+	view.UpdatedAt = time.Now().UTC()
+
+	if err := s.repo.Update(ctx, *view); err != nil {
+		if isNameConflict(err) {
+			return nil, apierrors.ErrViewNameExists
+		}
+		return nil, err
+	}
+	return view, nil
+}
+
+func (s *ViewsService) DeleteView(ctx context.Context, projectID, viewID string) (err error) {
+	ctx, span := telemetry.Start(ctx, "ViewsService.DeleteView")
+	defer func() { span.End(err) }()
+
+	if err := s.projectsService.EnsureProjectExists(ctx, projectID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, projectID, viewID)
+}
+
+// ListViewTasks runs a saved view: it lists the project's tasks with the
+// view's filter and sort and the paging in params.
+func (s *ViewsService) ListViewTasks(ctx context.Context, projectID, viewID string, params scheme.ListViewTasksParams) (_ pagination.Page[scheme.Task], err error) {
+	ctx, span := telemetry.Start(ctx, "ViewsService.ListViewTasks")
+	defer func() { span.End(err) }()
+
+	view, err := s.GetView(ctx, projectID, viewID)
+	if err != nil {
+		return pagination.Page[scheme.Task]{}, err
+	}
+	return s.tasksService.ListTasks(ctx, projectID, scheme.ListTasksParams{
+		Filter: view.Filter,
+		Sort:   view.Sort,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		After:  params.After,
+		Before: params.Before,
+	})
+}
+
+func (s *ViewsService) validateName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if name == "" {
+		return "", apierrors.ErrViewNameRequired
+	}
+	// View names share the limit of project names.
+	if len(name) > s.limits.ProjectNameMaxLength {
+		return "", apierrors.ErrViewNameTooLong
+	}
+	return name, nil
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func isNameConflict(err error) bool {
+	errStr := strings.ToLower(err.Error())
+	return strings.Contains(errStr, "unique") && strings.Contains(errStr, "task_views.")
+}
@@ -3,37 +3,167 @@ package store
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	_ "modernc.org/sqlite"
 )
 
-const schema = `
-PRAGMA foreign_keys = ON;
+const (
+	// MemoryDSN is the shared-cache in-memory database used by tests.
+	MemoryDSN = "file:todo?mode=memory&cache=shared"
 
-CREATE TABLE IF NOT EXISTS todos (
-  id         TEXT PRIMARY KEY,
-  title      TEXT NOT NULL,
-  completed  INTEGER NOT NULL DEFAULT 0,
-  created_at TEXT NOT NULL
-);
+	defaultBusyTimeout  = 5 * time.Second
+	defaultMaxReadConns = 4
+)
+
+// Config describes how to open the SQLite database.
+type Config struct {
+	// DSN is either an in-memory DSN (":memory:" or one with mode=memory) or an
+	// on-disk path / file: URI.
+	DSN string
+	// BusyTimeout is how long a connection waits on a locked database.
+	BusyTimeout time.Duration
+	// MaxReadConns caps the read pool. Writes always go through a single
+	// connection because SQLite allows only one writer at a time.
+	MaxReadConns int
+}
 
--- helpful composite index if you later filter/sort
-CREATE INDEX IF NOT EXISTS idx_todos_completed_created ON todos(completed, created_at);
-`
+// DB wraps the write pool (embedded, so BeginTx and friends go to the writer)
+// and a separate read-only pool. For in-memory databases both are the same
+// single connection. ExecContext, QueryContext and QueryRowContext are
+// overridden to trace every statement and to send reads to the read pool.
+type DB struct {
+	*sql.DB
+	reader *sql.DB
+	memory bool
+}
 
-func InMemory(ctx context.Context) (*sql.DB, error) {
-	dsn := "file:todo?mode=memory&cache=shared&_fk=1"
-	db, err := sql.Open("sqlite", dsn)
+// Open opens the database described by cfg, applies connection pragmas and
+// verifies both pools are reachable.
+func Open(ctx context.Context, cfg Config) (*DB, error) {
+	if strings.TrimSpace(cfg.DSN) == "" {
+		return nil, errors.New("store: dsn is required")
+	}
+	if cfg.BusyTimeout <= 0 {
+		cfg.BusyTimeout = defaultBusyTimeout
+	}
+	if cfg.MaxReadConns <= 0 {
+		cfg.MaxReadConns = defaultMaxReadConns
+	}
+
+	if IsMemory(cfg.DSN) {
+		return openMemory(ctx, cfg)
+	}
+
+	writer, err := sql.Open("sqlite", withPragmas(cfg.DSN, cfg, "_txlock=immediate", "_pragma=journal_mode(WAL)", "_pragma=synchronous(NORMAL)"))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("open writer: %w", err)
 	}
+	writer.SetMaxOpenConns(1)
+	writer.SetMaxIdleConns(1)
+	writer.SetConnMaxIdleTime(0)
+
+	// The writer must connect first so the file exists and WAL is switched on
+	// before readers attach.
+	if err := writer.PingContext(ctx); err != nil {
+		_ = writer.Close()
+		return nil, fmt.Errorf("ping writer: %w", err)
+	}
+
+	reader, err := sql.Open("sqlite", withPragmas(cfg.DSN, cfg, "_pragma=query_only(1)"))
+	if err != nil {
+		_ = writer.Close()
+		return nil, fmt.Errorf("open reader: %w", err)
+	}
+	reader.SetMaxOpenConns(cfg.MaxReadConns)
+	reader.SetMaxIdleConns(cfg.MaxReadConns)
+
+	if err := reader.PingContext(ctx); err != nil {
+		_ = reader.Close()
+		_ = writer.Close()
+		return nil, fmt.Errorf("ping reader: %w", err)
+	}
+
+	return &DB{DB: writer, reader: reader}, nil
+}
+
+// InMemory opens the shared in-memory database.
+func InMemory(ctx context.Context) (*DB, error) {
+	return Open(ctx, Config{DSN: MemoryDSN})
+}
 
+func openMemory(ctx context.Context, cfg Config) (*DB, error) {
+	db, err := sql.Open("sqlite", withPragmas(cfg.DSN, cfg))
+	if err != nil {
+		return nil, err
+	}
+	// Every new connection to a plain ":memory:" database is a fresh database,
+	// and shared-cache connections contend on table locks, so keep exactly one.
 	db.SetMaxOpenConns(1)
 	db.SetMaxIdleConns(1)
+	db.SetConnMaxIdleTime(0)
 
-	if _, err := db.ExecContext(ctx, schema); err != nil {
+	if err := db.PingContext(ctx); err != nil {
 		_ = db.Close()
 		return nil, err
 	}
-	return db, nil
+	return &DB{DB: db, reader: db, memory: true}, nil
+}
+
+// Reader returns the pool used for read-only queries.
+func (db *DB) Reader() *sql.DB {
+	return db.reader
+}
+
+// PoolStats returns the statistics of each connection pool by name. In-memory
+// databases have a single pool, reported as the writer.
+func (db *DB) PoolStats() map[string]sql.DBStats {
+	stats := map[string]sql.DBStats{"writer": db.DB.Stats()}
+	if db.reader != db.DB {
+		stats["reader"] = db.reader.Stats()
+	}
+	return stats
+}
+
+// IsMemory reports whether the database lives only in memory.
+func (db *DB) IsMemory() bool {
+	return db.memory
+}
+
+// Close checkpoints the write-ahead log into the main database file, so the
+// file is self-contained once the process exits, and closes both pools.
+func (db *DB) Close() error {
+	var errs []error
+	if !db.memory {
+		if _, err := db.DB.Exec("PRAGMA wal_checkpoint(TRUNCATE)"); err != nil {
+			errs = append(errs, fmt.Errorf("wal checkpoint: %w", err))
+		}
+	}
+	if db.reader != db.DB {
+		errs = append(errs, db.reader.Close())
+	}
+	errs = append(errs, db.DB.Close())
+	return errors.Join(errs...)
+}
+
+// IsMemory reports whether dsn points to an in-memory database.
+func IsMemory(dsn string) bool {
+	return dsn == ":memory:" || strings.Contains(dsn, "mode=memory")
+}
+
+func withPragmas(dsn string, cfg Config, extra ...string) string {
+	params := []string{
+		"_pragma=foreign_keys(1)",
+		fmt.Sprintf("_pragma=busy_timeout(%d)", cfg.BusyTimeout.Milliseconds()),
+	}
+	params = append(params, extra...)
+
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	return dsn + sep + strings.Join(params, "&")
 }
@@ -0,0 +1,219 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"full-stack-assesment/internal/helpers"
+	"full-stack-assesment/internal/migrate"
+	"full-stack-assesment/internal/store"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Open", func() {
+	var (
+		ctx context.Context
+		dsn string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dsn = filepath.Join(GinkgoT().TempDir(), "todo.db")
+	})
+
+	It("rejects an empty DSN", func() {
+		_, err := store.Open(ctx, store.Config{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("enables WAL and foreign keys on a file database", func() {
+		db, err := store.Open(ctx, store.Config{DSN: dsn})
+		Expect(err).NotTo(HaveOccurred())
+		defer db.Close()
+
+		var mode string
+		Expect(db.QueryRowContext(ctx, "PRAGMA journal_mode").Scan(&mode)).To(Succeed())
+		Expect(mode).To(Equal("wal"))
+
+		var fk int
+		Expect(db.Reader().QueryRowContext(ctx, "PRAGMA foreign_keys").Scan(&fk)).To(Succeed())
+		Expect(fk).To(Equal(1))
+	})
+
+	It("keeps the read pool read-only", func() {
+		db, err := store.Open(ctx, store.Config{DSN: dsn})
+		Expect(err).NotTo(HaveOccurred())
+		defer db.Close()
+
+		_, err = db.Reader().ExecContext(ctx, "CREATE TABLE t (id INTEGER)")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("sends queries that write to the write pool", func() {
+		db, err := store.Open(ctx, store.Config{DSN: dsn})
+		Expect(err).NotTo(HaveOccurred())
+		defer db.Close()
+		_, err = db.ExecContext(ctx, "CREATE TABLE t (id INTEGER PRIMARY KEY, n INTEGER)")
+		Expect(err).NotTo(HaveOccurred())
+
+		var id int
+		Expect(db.QueryRowContext(ctx, "INSERT INTO t (n) VALUES (1) RETURNING id").Scan(&id)).To(Succeed())
+		rows, err := db.QueryContext(ctx, "UPDATE t SET n = n + 1 WHERE id = ? RETURNING n", id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rows.Close()).To(Succeed())
+
+		var n int
+		Expect(db.QueryRowContext(ctx, "SELECT n FROM t WHERE id = ?", id).Scan(&n)).To(Succeed())
+		Expect(n).To(Equal(2))
+	})
+
+	It("persists data across reopen", func() {
+		db, err := store.Open(ctx, store.Config{DSN: dsn})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
+		_, err = db.ExecContext(ctx, `INSERT INTO projects (id, name, created_at, updated_at) VALUES ('p1', 'Persisted', 'x', 'x')`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.Close()).To(Succeed())
+
+		db, err = store.Open(ctx, store.Config{DSN: dsn})
+		Expect(err).NotTo(HaveOccurred())
+		defer db.Close()
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
+
+		var name string
+		Expect(db.Reader().QueryRowContext(ctx, `SELECT name FROM projects WHERE id = 'p1'`).Scan(&name)).To(Succeed())
+		Expect(name).To(Equal("Persisted"))
+	})
+
+	It("stores the sample rows' timestamps as the repositories write them", func() {
+		db, err := store.Open(ctx, store.Config{DSN: dsn})
+		Expect(err).NotTo(HaveOccurred())
+		defer db.Close()
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
+
+		var created, updated string
+		Expect(db.Reader().QueryRowContext(ctx, `SELECT created_at, updated_at FROM tasks WHERE id = 'bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb'`).Scan(&created, &updated)).To(Succeed())
+		Expect(created).To(Equal(helpers.FormatTime(time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC))))
+		Expect(updated).To(Equal(helpers.FormatTime(time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC))))
+		Expect(db.Reader().QueryRowContext(ctx, `SELECT created_at FROM projects WHERE id = 'aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa'`).Scan(&created)).To(Succeed())
+		Expect(created).To(Equal(helpers.FormatTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))))
+	})
+
+	It("checkpoints the write-ahead log on close", func() {
+		db, err := store.Open(ctx, store.Config{DSN: dsn})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
+		Expect(db.Close()).To(Succeed())
+
+		info, err := os.Stat(dsn + "-wal")
+		if err == nil {
+			Expect(info.Size()).To(BeZero())
+		} else {
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		}
+	})
+
+	It("shares one connection for in-memory databases", func() {
+		db, err := store.Open(ctx, store.Config{DSN: ":memory:"})
+		Expect(err).NotTo(HaveOccurred())
+		defer db.Close()
+
+		Expect(db.IsMemory()).To(BeTrue())
+		Expect(db.Reader()).To(BeIdenticalTo(db.DB))
+	})
+})
+
+var _ = Describe("InTx", func() {
+	var (
+		ctx context.Context
+		db  *store.DB
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		db, err = store.Open(ctx, store.Config{DSN: filepath.Join(GinkgoT().TempDir(), "todo.db")})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(db.Close)
+		Expect(migrate.Apply(ctx, db.DB)).To(Succeed())
+	})
+
+	insert := func(ctx context.Context, id string) error {
+		_, err := db.ExecContext(ctx, `INSERT INTO projects (id, name, created_at, updated_at) VALUES (?, ?, 'x', 'x')`, id, id)
+		return err
+	}
+	count := func(ctx context.Context) int {
+		var n int
+		ExpectWithOffset(1, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM projects`).Scan(&n)).To(Succeed())
+		return n
+	}
+
+	It("commits when fn succeeds and reads its own writes inside", func() {
+		before := count(ctx)
+		Expect(db.InTx(ctx, func(ctx context.Context) error {
+			Expect(insert(ctx, "p1")).To(Succeed())
+			Expect(count(ctx)).To(Equal(before + 1))
+			return nil
+		})).To(Succeed())
+		Expect(count(ctx)).To(Equal(before + 1))
+	})
+
+	It("rolls back when fn fails or panics", func() {
+		before := count(ctx)
+		boom := errors.New("boom")
+		Expect(db.InTx(ctx, func(ctx context.Context) error {
+			Expect(insert(ctx, "p1")).To(Succeed())
+			return boom
+		})).To(MatchError(boom))
+		Expect(count(ctx)).To(Equal(before))
+
+		Expect(func() {
+			_ = db.InTx(ctx, func(ctx context.Context) error {
+				Expect(insert(ctx, "p2")).To(Succeed())
+				panic("boom")
+			})
+		}).To(PanicWith("boom"))
+		Expect(count(ctx)).To(Equal(before))
+	})
+
+	It("runs nested calls in savepoints that fail on their own", func() {
+		before := count(ctx)
+		Expect(db.InTx(ctx, func(ctx context.Context) error {
+			Expect(insert(ctx, "p1")).To(Succeed())
+			err := db.InTx(ctx, func(ctx context.Context) error {
+				Expect(insert(ctx, "p2")).To(Succeed())
+				return errors.New("inner fails")
+			})
+			Expect(err).To(MatchError("inner fails"))
+			Expect(db.InTx(ctx, func(ctx context.Context) error { return insert(ctx, "p3") })).To(Succeed())
+			return nil
+		})).To(Succeed())
+		Expect(count(ctx)).To(Equal(before + 2))
+
+		var ids []string
+		rows, err := db.QueryContext(ctx, `SELECT id FROM projects WHERE id IN ('p1', 'p2', 'p3') ORDER BY id`)
+		Expect(err).NotTo(HaveOccurred())
+		defer rows.Close()
+		for rows.Next() {
+			var id string
+			Expect(rows.Scan(&id)).To(Succeed())
+			ids = append(ids, id)
+		}
+		Expect(ids).To(Equal([]string{"p1", "p3"}))
+	})
+
+	It("rolls nested work back with the outer transaction", func() {
+		before := count(ctx)
+		err := db.InTx(ctx, func(ctx context.Context) error {
+			Expect(db.InTx(ctx, func(ctx context.Context) error { return insert(ctx, "p1") })).To(Succeed())
+			return errors.New("outer fails")
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(count(ctx)).To(Equal(before))
+	})
+})
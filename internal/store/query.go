@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"full-stack-assesment/internal/telemetry"
+)
+
+// ExecContext runs a statement on the write pool, or in the transaction ctx
+// carries, inside a trace span.
+func (db *DB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ctx, span, _ := startSpan(ctx, query)
+	var (
+		res sql.Result
+		err error
+	)
+	if tx := db.txFrom(ctx); tx != nil {
+		res, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		res, err = db.DB.ExecContext(ctx, query, args...)
+	}
+	if err == nil {
+		if n, rerr := res.RowsAffected(); rerr == nil {
+			span.SetAttributes(telemetry.Int64("db.rows_affected", n))
+		}
+	}
+	span.End(err)
+	return res, err
+}
+
+// QueryContext runs a query in the transaction ctx carries, or else on the
+// pool its verb calls for (see pool), inside a trace span. The span covers
+// executing the statement, not iterating the rows.
+func (db *DB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	ctx, span, op := startSpan(ctx, query)
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if tx := db.txFrom(ctx); tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = db.pool(op).QueryContext(ctx, query, args...)
+	}
+	span.End(err)
+	return rows, err
+}
+
+// QueryRowContext runs a single-row query in the transaction ctx carries, or
+// else on the pool its verb calls for (see pool), inside a trace span.
+func (db *DB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	ctx, span, op := startSpan(ctx, query)
+	var row *sql.Row
+	if tx := db.txFrom(ctx); tx != nil {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = db.pool(op).QueryRowContext(ctx, query, args...)
+	}
+	span.End(row.Err())
+	return row
+}
+
+// pool picks the pool for a query run outside a transaction: the read pool
+// for SELECT and WITH, and the write pool for any other verb, such as an
+// INSERT, UPDATE or DELETE with RETURNING, which the read-only pool would
+// refuse.
+func (db *DB) pool(op string) *sql.DB {
+	if op == "SELECT" || op == "WITH" {
+		return db.reader
+	}
+	return db.DB
+}
+
+// startSpan names the span after the SQL verb, following the OpenTelemetry
+// database conventions, and returns the verb.
+func startSpan(ctx context.Context, query string) (context.Context, *telemetry.Span, string) {
+	stmt := strings.Join(strings.Fields(query), " ")
+	op, _, _ := strings.Cut(stmt, " ")
+	op = strings.ToUpper(op)
+	ctx, span := telemetry.Default().Start(ctx, op, telemetry.KindClient,
+		telemetry.String("db.system", "sqlite"),
+		telemetry.String("db.operation", op),
+		telemetry.String("db.statement", stmt),
+	)
+	return ctx, span, op
+}
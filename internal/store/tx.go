@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"full-stack-assesment/internal/telemetry"
+)
+
+type txKey struct{}
+
+// txState is the transaction a context carries and the database it belongs
+// to, so a context handed to another database never picks it up.
+type txState struct {
+	db *DB
+	tx *sql.Tx
+	// savepoints numbers the savepoints of nested InTx calls.
+	savepoints int
+}
+
+// InTx runs fn in a transaction on the write pool. The transaction travels in
+// the context fn receives: ExecContext, QueryContext and QueryRowContext run
+// inside it when given that context, reads included, so fn sees its own
+// writes. The transaction commits if fn returns nil and rolls back if it
+// returns an error or panics.
+//
+// Called with a context that already carries a transaction of db, InTx runs
+// fn in a savepoint of that transaction instead: an error from fn undoes only
+// what fn did, and the outer transaction carries on. Committing stays with
+// the outermost call.
+func (db *DB) InTx(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if st, ok := ctx.Value(txKey{}).(*txState); ok && st.db == db {
+		return st.savepoint(ctx, fn)
+	}
+
+	ctx, span := telemetry.Default().Start(ctx, "TRANSACTION", telemetry.KindClient,
+		telemetry.String("db.system", "sqlite"),
+	)
+	defer func() { span.End(err) }()
+
+	tx, err := db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, &txState{db: db, tx: tx})); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// savepoint runs fn between SAVEPOINT and RELEASE, rolling back to the
+// savepoint if fn fails or panics.
+func (st *txState) savepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	st.savepoints++
+	name := "sp" + strconv.Itoa(st.savepoints)
+	if _, err := st.db.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+	undo := func() {
+		_, _ = st.db.ExecContext(ctx, "ROLLBACK TO "+name)
+		_, _ = st.db.ExecContext(ctx, "RELEASE "+name)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			undo()
+			panic(p)
+		}
+	}()
+
+	if err := fn(ctx); err != nil {
+		undo()
+		return err
+	}
+	_, err := st.db.ExecContext(ctx, "RELEASE "+name)
+	return err
+}
+
+// txFrom returns the transaction of db that ctx carries, if any.
+func (db *DB) txFrom(ctx context.Context) *sql.Tx {
+	if st, ok := ctx.Value(txKey{}).(*txState); ok && st.db == db {
+		return st.tx
+	}
+	return nil
+}
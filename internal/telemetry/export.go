@@ -0,0 +1,230 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// Exporter names accepted by NewExporter.
+const (
+	ExporterNone     = "none"
+	ExporterStdout   = "stdout"
+	ExporterOTLPFile = "otlp-file"
+)
+
+// NewExporter builds the exporter called name. path is the output file of
+// the otlp-file exporter. The none exporter is nil, which disables tracing.
+func NewExporter(name, path, serviceName string) (Exporter, error) {
+	switch name {
+	case "", ExporterNone:
+		return nil, nil
+	case ExporterStdout:
+		return NewStdoutExporter(os.Stdout), nil
+	case ExporterOTLPFile:
+		exp, err := NewOTLPFileExporter(path, serviceName)
+		if err != nil {
+			return nil, err
+		}
+		return exp, nil
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", name)
+	}
+}
+
+// StdoutExporter writes one flat JSON object per span, for reading next to
+// the JSON logs.
+type StdoutExporter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func NewStdoutExporter(w io.Writer) *StdoutExporter {
+	return &StdoutExporter{enc: json.NewEncoder(w)}
+}
+
+type stdoutSpan struct {
+	Span         string         `json:"span"`
+	TraceID      string         `json:"trace_id"`
+	SpanID       string         `json:"span_id"`
+	ParentSpanID string         `json:"parent_span_id,omitempty"`
+	Kind         Kind           `json:"kind"`
+	Start        string         `json:"start"`
+	DurationMs   float64        `json:"duration_ms"`
+	Attributes   map[string]any `json:"attributes,omitempty"`
+	Error        string         `json:"error,omitempty"`
+}
+
+func (e *StdoutExporter) ExportSpan(s SpanData) error {
+	out := stdoutSpan{
+		Span:         s.Name,
+		TraceID:      s.TraceID,
+		SpanID:       s.SpanID,
+		ParentSpanID: s.ParentSpanID,
+		Kind:         s.Kind,
+		Start:        s.Start.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		DurationMs:   float64(s.End.Sub(s.Start).Microseconds()) / 1000,
+		Error:        s.Err,
+	}
+	if len(s.Attributes) > 0 {
+		out.Attributes = make(map[string]any, len(s.Attributes))
+		for _, a := range s.Attributes {
+			out.Attributes[a.Key] = a.Value
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.enc.Encode(out)
+}
+
+func (e *StdoutExporter) Shutdown(context.Context) error { return nil }
+
+// OTLPFileExporter appends spans to a file in the OTLP/JSON encoding, one
+// ExportTraceServiceRequest per line, the format read by the OpenTelemetry
+// Collector's otlpjsonfile receiver.
+type OTLPFileExporter struct {
+	mu      sync.Mutex
+	f       *os.File
+	enc     *json.Encoder
+	service string
+}
+
+func NewOTLPFileExporter(path, serviceName string) (*OTLPFileExporter, error) {
+	if path == "" {
+		return nil, fmt.Errorf("otlp-file exporter: path is required")
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("otlp-file exporter: %w", err)
+	}
+	return &OTLPFileExporter{f: f, enc: json.NewEncoder(f), service: serviceName}, nil
+}
+
+// OTLP/JSON message shapes, limited to the fields written here.
+type (
+	OTLPTraces struct {
+		ResourceSpans []OTLPResourceSpans `json:"resourceSpans"`
+	}
+	OTLPResourceSpans struct {
+		Resource   OTLPResource     `json:"resource"`
+		ScopeSpans []OTLPScopeSpans `json:"scopeSpans"`
+	}
+	OTLPResource struct {
+		Attributes []OTLPKeyValue `json:"attributes"`
+	}
+	OTLPScopeSpans struct {
+		Scope OTLPScope  `json:"scope"`
+		Spans []OTLPSpan `json:"spans"`
+	}
+	OTLPScope struct {
+		Name string `json:"name"`
+	}
+	OTLPSpan struct {
+		TraceID           string         `json:"traceId"`
+		SpanID            string         `json:"spanId"`
+		ParentSpanID      string         `json:"parentSpanId,omitempty"`
+		Name              string         `json:"name"`
+		Kind              int            `json:"kind"`
+		StartTimeUnixNano string         `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+		Attributes        []OTLPKeyValue `json:"attributes,omitempty"`
+		Status            OTLPStatus     `json:"status"`
+	}
+	OTLPKeyValue struct {
+		Key   string    `json:"key"`
+		Value OTLPValue `json:"value"`
+	}
+	OTLPValue struct {
+		StringValue *string  `json:"stringValue,omitempty"`
+		BoolValue   *bool    `json:"boolValue,omitempty"`
+		IntValue    *string  `json:"intValue,omitempty"`
+		DoubleValue *float64 `json:"doubleValue,omitempty"`
+	}
+	OTLPStatus struct {
+		Code    int    `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// OTLP span kinds and status codes.
+const (
+	otlpKindInternal = 1
+	otlpKindServer   = 2
+	otlpKindClient   = 3
+
+	otlpStatusOK    = 1
+	otlpStatusError = 2
+)
+
+func (e *OTLPFileExporter) ExportSpan(s SpanData) error {
+	span := OTLPSpan{
+		TraceID:           s.TraceID,
+		SpanID:            s.SpanID,
+		ParentSpanID:      s.ParentSpanID,
+		Name:              s.Name,
+		Kind:              otlpKind(s.Kind),
+		StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.End.UnixNano(), 10),
+		Status:            OTLPStatus{Code: otlpStatusOK},
+	}
+	for _, a := range s.Attributes {
+		span.Attributes = append(span.Attributes, otlpKeyValue(a))
+	}
+	if s.Err != "" {
+		span.Status = OTLPStatus{Code: otlpStatusError, Message: s.Err}
+	}
+
+	msg := OTLPTraces{ResourceSpans: []OTLPResourceSpans{{
+		Resource:   OTLPResource{Attributes: []OTLPKeyValue{otlpKeyValue(String("service.name", e.service))}},
+		ScopeSpans: []OTLPScopeSpans{{Scope: OTLPScope{Name: "full-stack-assesment/internal/telemetry"}, Spans: []OTLPSpan{span}}},
+	}}}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.enc.Encode(msg)
+}
+
+func (e *OTLPFileExporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err := e.f.Sync(); err != nil {
+		_ = e.f.Close()
+		return err
+	}
+	return e.f.Close()
+}
+
+func otlpKind(k Kind) int {
+	switch k {
+	case KindServer:
+		return otlpKindServer
+	case KindClient:
+		return otlpKindClient
+	default:
+		return otlpKindInternal
+	}
+}
+
+func otlpKeyValue(a Attr) OTLPKeyValue {
+	var v OTLPValue
+	switch x := a.Value.(type) {
+	case string:
+		v.StringValue = &x
+	case bool:
+		v.BoolValue = &x
+	case int64:
+		s := strconv.FormatInt(x, 10)
+		v.IntValue = &s
+	case float64:
+		v.DoubleValue = &x
+	default:
+		s := fmt.Sprint(x)
+		v.StringValue = &s
+	}
+	return OTLPKeyValue{Key: a.Key, Value: v}
+}
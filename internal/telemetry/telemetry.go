@@ -0,0 +1,281 @@
+// Package telemetry records OpenTelemetry-style trace spans. Spans travel in
+// context.Context from the HTTP middleware through services down to every SQL
+// statement, and finished spans go to a pluggable Exporter. It deliberately
+// implements only what the service uses instead of the full OpenTelemetry SDK.
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"full-stack-assesment/internal/requestid"
+)
+
+// Kind tells whether a span serves a remote call or runs inside the process.
+type Kind string
+
+const (
+	KindServer   Kind = "server"
+	KindInternal Kind = "internal"
+	KindClient   Kind = "client"
+)
+
+// Attr is a span attribute. Values should be strings, bools, integers or
+// floats.
+type Attr struct {
+	Key   string
+	Value any
+}
+
+func String(key, value string) Attr        { return Attr{key, value} }
+func Int(key string, value int) Attr       { return Attr{key, int64(value)} }
+func Int64(key string, value int64) Attr   { return Attr{key, value} }
+func Bool(key string, value bool) Attr     { return Attr{key, value} }
+func Float(key string, value float64) Attr { return Attr{key, value} }
+
+// SpanData is the immutable record of a finished span handed to exporters.
+type SpanData struct {
+	TraceID      string
+	SpanID       string
+	ParentSpanID string
+	Name         string
+	Kind         Kind
+	Start        time.Time
+	End          time.Time
+	Attributes   []Attr
+	// Err is the error message when the operation failed.
+	Err string
+}
+
+// Exporter receives finished, sampled spans.
+type Exporter interface {
+	ExportSpan(SpanData) error
+	// Shutdown flushes buffered spans and releases resources.
+	Shutdown(ctx context.Context) error
+}
+
+// Tracer creates spans and hands sampled ones to its exporter.
+type Tracer struct {
+	exporter Exporter
+	sampler  Sampler
+}
+
+// NewTracer returns a tracer exporting to exp. A nil exporter records nothing.
+func NewTracer(exp Exporter, sampler Sampler) *Tracer {
+	if sampler == nil {
+		sampler = AlwaysSample()
+	}
+	return &Tracer{exporter: exp, sampler: sampler}
+}
+
+// Shutdown flushes the exporter.
+func (t *Tracer) Shutdown(ctx context.Context) error {
+	if t == nil || t.exporter == nil {
+		return nil
+	}
+	return t.exporter.Shutdown(ctx)
+}
+
+var defaultTracer atomic.Pointer[Tracer]
+
+// SetDefault installs t as the tracer used by Start. Passing nil disables
+// tracing.
+func SetDefault(t *Tracer) {
+	defaultTracer.Store(t)
+}
+
+// Default returns the tracer installed with SetDefault, or nil.
+func Default() *Tracer {
+	return defaultTracer.Load()
+}
+
+// Start starts an internal span with the default tracer. See Tracer.Start.
+func Start(ctx context.Context, name string, attrs ...Attr) (context.Context, *Span) {
+	return Default().Start(ctx, name, KindInternal, attrs...)
+}
+
+// Start starts a span as a child of the span in ctx. Without a parent span the
+// new span becomes the root of a trace: it adopts the trace and span IDs that
+// requestid stored in ctx, so exported spans match the traceparent echoed to
+// the caller, and otherwise gets fresh IDs. The sampling decision is made for
+// the root and inherited by every descendant.
+//
+// Start is safe on a nil tracer and returns a span that records nothing.
+func (t *Tracer) Start(ctx context.Context, name string, kind Kind, attrs ...Attr) (context.Context, *Span) {
+	if t == nil || t.exporter == nil {
+		return ctx, nil
+	}
+
+	s := &Span{
+		tracer: t,
+		data: SpanData{
+			Name:       name,
+			Kind:       kind,
+			Start:      time.Now(),
+			Attributes: attrs,
+		},
+	}
+
+	if parent := FromContext(ctx); parent != nil {
+		s.data.TraceID = parent.data.TraceID
+		s.data.ParentSpanID = parent.data.SpanID
+		s.data.SpanID = newSpanID()
+		s.sampled = parent.sampled
+	} else if ids, ok := requestid.FromContext(ctx); ok {
+		s.data.TraceID, s.data.SpanID, s.data.ParentSpanID = ids.TraceID, ids.SpanID, ids.ParentID
+		s.sampled = t.sampler.ShouldSample(ids.TraceID, remoteSampled(ids))
+	} else {
+		s.data.TraceID, s.data.SpanID = newTraceID(), newSpanID()
+		s.sampled = t.sampler.ShouldSample(s.data.TraceID, nil)
+	}
+
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+// remoteSampled returns the caller's sampling decision from its traceparent
+// flags, or nil when the caller did not send one.
+func remoteSampled(ids requestid.IDs) *bool {
+	if ids.ParentID == "" {
+		return nil
+	}
+	b, err := hex.DecodeString(ids.Flags)
+	sampled := err == nil && len(b) == 1 && b[0]&1 == 1
+	return &sampled
+}
+
+type spanKey struct{}
+
+// FromContext returns the span started last in ctx, or nil.
+func FromContext(ctx context.Context) *Span {
+	s, _ := ctx.Value(spanKey{}).(*Span)
+	return s
+}
+
+// Span is an operation being timed. All methods are safe on a nil span, which
+// is what Start returns when tracing is disabled.
+type Span struct {
+	tracer  *Tracer
+	sampled bool
+
+	mu    sync.Mutex
+	data  SpanData
+	ended bool
+}
+
+// SetName renames the span, for names only known once the work is done, such
+// as the route of an HTTP request.
+func (s *Span) SetName(name string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.data.Name = name
+	s.mu.Unlock()
+}
+
+func (s *Span) SetAttributes(attrs ...Attr) {
+	if s == nil || !s.sampled {
+		return
+	}
+	s.mu.Lock()
+	s.data.Attributes = append(s.data.Attributes, attrs...)
+	s.mu.Unlock()
+}
+
+// End finishes the span, marking it failed when err is not nil, and exports it
+// if it was sampled. Calls after the first are ignored.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.End = time.Now()
+	if err != nil {
+		s.data.Err = err.Error()
+	}
+	data := s.data
+	s.mu.Unlock()
+
+	if s.sampled {
+		_ = s.tracer.exporter.ExportSpan(data)
+	}
+}
+
+// TraceID returns the ID of the span's trace, or "" for a nil span.
+func (s *Span) TraceID() string {
+	if s == nil {
+		return ""
+	}
+	return s.data.TraceID
+}
+
+// Sampled reports whether the span will be exported.
+func (s *Span) Sampled() bool {
+	return s != nil && s.sampled
+}
+
+// Sampler decides whether a new trace is recorded.
+type Sampler interface {
+	// ShouldSample is called for root spans. parentSampled is the remote
+	// caller's decision, or nil when there is no remote parent.
+	ShouldSample(traceID string, parentSampled *bool) bool
+}
+
+type samplerFunc func(traceID string, parentSampled *bool) bool
+
+func (f samplerFunc) ShouldSample(traceID string, parentSampled *bool) bool {
+	return f(traceID, parentSampled)
+}
+
+// AlwaysSample records every trace.
+func AlwaysSample() Sampler {
+	return samplerFunc(func(string, *bool) bool { return true })
+}
+
+// ParentBasedRatio follows the remote caller's sampling decision when there
+// is one and otherwise records the given fraction of traces, chosen by trace
+// ID so every service sampling at the same ratio keeps the same traces.
+func ParentBasedRatio(ratio float64) Sampler {
+	bound := ratioBound(ratio)
+	return samplerFunc(func(traceID string, parentSampled *bool) bool {
+		if parentSampled != nil {
+			return *parentSampled
+		}
+		b, err := hex.DecodeString(traceID)
+		if err != nil || len(b) != 16 {
+			return false
+		}
+		// Compare the low 63 bits, as the OpenTelemetry TraceIdRatioBased
+		// sampler does.
+		return binary.BigEndian.Uint64(b[8:])>>1 < bound
+	})
+}
+
+func ratioBound(ratio float64) uint64 {
+	switch {
+	case ratio >= 1:
+		return 1 << 63
+	case ratio <= 0:
+		return 0
+	}
+	return uint64(ratio * (1 << 63))
+}
+
+func newTraceID() string { return randomHex(16) }
+func newSpanID() string  { return randomHex(8) }
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
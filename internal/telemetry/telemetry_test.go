@@ -0,0 +1,179 @@
+package telemetry_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"full-stack-assesment/internal/requestid"
+	"full-stack-assesment/internal/telemetry"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// recorder keeps exported spans in memory.
+type recorder struct{ spans []telemetry.SpanData }
+
+func (r *recorder) ExportSpan(s telemetry.SpanData) error {
+	r.spans = append(r.spans, s)
+	return nil
+}
+
+func (r *recorder) Shutdown(context.Context) error { return nil }
+
+var _ = Describe("Tracer", func() {
+	var (
+		rec *recorder
+		ctx = context.Background()
+	)
+
+	BeforeEach(func() {
+		rec = &recorder{}
+	})
+
+	It("links child spans to their parent", func() {
+		t := telemetry.NewTracer(rec, nil)
+		ctx, root := t.Start(ctx, "root", telemetry.KindServer)
+		_, child := t.Start(ctx, "child", telemetry.KindInternal, telemetry.String("k", "v"))
+		child.End(errors.New("boom"))
+		root.End(nil)
+
+		Expect(rec.spans).To(HaveLen(2))
+		c, r := rec.spans[0], rec.spans[1]
+		Expect(c.Name).To(Equal("child"))
+		Expect(c.TraceID).To(Equal(r.TraceID))
+		Expect(c.ParentSpanID).To(Equal(r.SpanID))
+		Expect(c.Err).To(Equal("boom"))
+		Expect(c.Attributes).To(ContainElement(telemetry.String("k", "v")))
+		Expect(r.ParentSpanID).To(BeEmpty())
+		Expect(r.End).NotTo(BeTemporally("<", r.Start))
+	})
+
+	It("roots traces at the request IDs", func() {
+		t := telemetry.NewTracer(rec, nil)
+		ids := requestid.New("", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		_, span := t.Start(requestid.NewContext(ctx, ids), "GET", telemetry.KindServer)
+		span.End(nil)
+
+		Expect(rec.spans[0].TraceID).To(Equal(ids.TraceID))
+		Expect(rec.spans[0].SpanID).To(Equal(ids.SpanID))
+		Expect(rec.spans[0].ParentSpanID).To(Equal("00f067aa0ba902b7"))
+	})
+
+	It("exports each span once", func() {
+		_, span := telemetry.NewTracer(rec, nil).Start(ctx, "once", telemetry.KindInternal)
+		span.End(nil)
+		span.End(nil)
+		Expect(rec.spans).To(HaveLen(1))
+	})
+
+	It("is a no-op without a tracer", func() {
+		got, span := (*telemetry.Tracer)(nil).Start(ctx, "noop", telemetry.KindInternal)
+		Expect(got).To(Equal(ctx))
+		Expect(span).To(BeNil())
+		span.SetAttributes(telemetry.Int("n", 1))
+		span.End(nil)
+	})
+
+	Describe("sampling", func() {
+		It("drops whole traces at ratio 0", func() {
+			t := telemetry.NewTracer(rec, telemetry.ParentBasedRatio(0))
+			ctx, root := t.Start(ctx, "root", telemetry.KindServer)
+			_, child := t.Start(ctx, "child", telemetry.KindInternal)
+			child.End(nil)
+			root.End(nil)
+			Expect(root.Sampled()).To(BeFalse())
+			Expect(rec.spans).To(BeEmpty())
+		})
+
+		It("samples roughly the configured ratio", func() {
+			t := telemetry.NewTracer(rec, telemetry.ParentBasedRatio(0.25))
+			for range 4000 {
+				_, s := t.Start(ctx, "s", telemetry.KindInternal)
+				s.End(nil)
+			}
+			Expect(len(rec.spans)).To(BeNumerically("~", 1000, 150))
+		})
+
+		It("follows the caller's sampled flag", func() {
+			t := telemetry.NewTracer(rec, telemetry.ParentBasedRatio(1))
+			ids := requestid.New("", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+			_, span := t.Start(requestid.NewContext(ctx, ids), "GET", telemetry.KindServer)
+			span.End(nil)
+			Expect(rec.spans).To(BeEmpty())
+
+			t = telemetry.NewTracer(rec, telemetry.ParentBasedRatio(0))
+			ids = requestid.New("", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+			_, span = t.Start(requestid.NewContext(ctx, ids), "GET", telemetry.KindServer)
+			span.End(nil)
+			Expect(rec.spans).To(HaveLen(1))
+		})
+	})
+})
+
+var _ = Describe("Exporters", func() {
+	ctx := context.Background()
+
+	It("writes one JSON object per span to stdout", func() {
+		var buf bytes.Buffer
+		t := telemetry.NewTracer(telemetry.NewStdoutExporter(&buf), nil)
+		_, span := t.Start(ctx, "SELECT", telemetry.KindClient, telemetry.String("db.system", "sqlite"))
+		span.End(nil)
+
+		var got map[string]any
+		Expect(json.Unmarshal(buf.Bytes(), &got)).To(Succeed())
+		Expect(got).To(HaveKeyWithValue("span", "SELECT"))
+		Expect(got).To(HaveKeyWithValue("kind", "client"))
+		Expect(got).To(HaveKeyWithValue("trace_id", span.TraceID()))
+		Expect(got["attributes"]).To(HaveKeyWithValue("db.system", "sqlite"))
+	})
+
+	It("appends OTLP/JSON lines to a file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "spans.jsonl")
+		exp, err := telemetry.NewExporter(telemetry.ExporterOTLPFile, path, "todo-test")
+		Expect(err).NotTo(HaveOccurred())
+		t := telemetry.NewTracer(exp, nil)
+
+		ctx, root := t.Start(ctx, "GET GetTask", telemetry.KindServer)
+		_, child := t.Start(ctx, "SELECT", telemetry.KindClient, telemetry.Int("db.rows_affected", 3), telemetry.Bool("cached", false))
+		child.End(errors.New("no such table"))
+		root.End(nil)
+		Expect(t.Shutdown(ctx)).To(Succeed())
+
+		f, err := os.Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		defer f.Close()
+		var lines []telemetry.OTLPTraces
+		sc := bufio.NewScanner(f)
+		for sc.Scan() {
+			var msg telemetry.OTLPTraces
+			Expect(json.Unmarshal(sc.Bytes(), &msg)).To(Succeed())
+			lines = append(lines, msg)
+		}
+		Expect(lines).To(HaveLen(2))
+
+		rs := lines[0].ResourceSpans[0]
+		Expect(*rs.Resource.Attributes[0].Value.StringValue).To(Equal("todo-test"))
+		span := rs.ScopeSpans[0].Spans[0]
+		Expect(span.Name).To(Equal("SELECT"))
+		Expect(span.Kind).To(Equal(3))
+		Expect(span.Status.Code).To(Equal(2))
+		Expect(span.Status.Message).To(Equal("no such table"))
+		Expect(*span.Attributes[0].Value.IntValue).To(Equal("3"))
+		Expect(*span.Attributes[1].Value.BoolValue).To(BeFalse())
+		Expect(span.ParentSpanID).To(Equal(lines[1].ResourceSpans[0].ScopeSpans[0].Spans[0].SpanID))
+	})
+
+	It("rejects unknown exporters", func() {
+		_, err := telemetry.NewExporter("jaeger", "", "svc")
+		Expect(err).To(HaveOccurred())
+		exp, err := telemetry.NewExporter(telemetry.ExporterNone, "", "svc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exp).To(BeNil())
+	})
+})
@@ -0,0 +1,51 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"full-stack-assesment/internal/worker"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Group", func() {
+	It("cancels workers and waits for them on Stop", func() {
+		g := worker.NewGroup(context.Background())
+		var stopped atomic.Int32
+		for range 3 {
+			g.Go("ticker", func(ctx context.Context) error {
+				<-ctx.Done()
+				stopped.Add(1)
+				return ctx.Err()
+			})
+		}
+
+		Expect(g.Stop(context.Background())).To(Succeed())
+		Expect(stopped.Load()).To(BeEquivalentTo(3))
+	})
+
+	It("reports worker failures", func() {
+		g := worker.NewGroup(context.Background())
+		g.Go("broken", func(ctx context.Context) error { return errors.New("boom") })
+
+		Expect(g.Stop(context.Background())).To(MatchError("boom"))
+	})
+
+	It("gives up when the stop deadline passes", func() {
+		g := worker.NewGroup(context.Background())
+		release := make(chan struct{})
+		defer close(release)
+		g.Go("stuck", func(ctx context.Context) error {
+			<-release
+			return nil
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		Expect(g.Stop(ctx)).To(MatchError(context.DeadlineExceeded))
+	})
+})